@@ -0,0 +1,97 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleAllIn calculates each player's chip EV in an all-in showdown with
+// side pots.
+func (h *Handler) HandleAllIn(c *gin.Context) {
+	var req models.AllInRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+	if len(boardCards) > 5 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Board cannot have more than 5 cards")
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	known := append(append([]*card.Card{}, boardCards...), deadCards...)
+	players := make([]simulator.AllInPlayer, len(req.Players))
+	for i, player := range req.Players {
+		holeCards, err := card.ParseCards(player.HoleCards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err),
+				fmt.Sprintf("Invalid player %d hole cards: %s", i+1, err))
+			return
+		}
+		if len(holeCards) != 2 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+				fmt.Sprintf("Player %d must have exactly 2 hole cards", i+1))
+			return
+		}
+		players[i] = simulator.AllInPlayer{HoleCards: holeCards, Committed: player.Committed}
+		known = append(known, holeCards...)
+	}
+
+	if err := card.CheckUnique(known); err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, "Invalid cards: "+err.Error())
+		return
+	}
+
+	result, err := h.engine.AllInEquity(simulator.AllInParams{
+		Players:     players,
+		BoardCards:  boardCards,
+		DeadCards:   deadCards,
+		Simulations: req.Simulations,
+		Workers:     req.Workers,
+		Seed:        (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	pots := make([]models.Pot, len(result.Pots))
+	for i, pot := range result.Pots {
+		pots[i] = models.Pot{Amount: pot.Amount, Eligible: pot.Eligible}
+	}
+
+	playerResults := make([]models.AllInPlayerResult, len(result.Players))
+	for i, equity := range result.Players {
+		playerResults[i] = models.AllInPlayerResult{
+			HoleCards:     req.Players[i].HoleCards,
+			Committed:     req.Players[i].Committed,
+			ExpectedChips: equity.ExpectedChips,
+			Equity:        equity.Equity,
+		}
+	}
+
+	c.JSON(http.StatusOK, models.AllInResponse{Pots: pots, Players: playerResults})
+}
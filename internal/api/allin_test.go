@@ -0,0 +1,40 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+)
+
+// TestAllInTooFewCardsForBoard sends ten players and 30 dead cards, leaving
+// two cards for a five-card board. It must be a client error, not a panic
+// on a worker goroutine.
+func TestAllInTooFewCardsForBoard(t *testing.T) {
+	deck := card.NewDeck()
+	req := models.AllInRequest{Simulations: 10}
+	for i := 0; i < 10; i++ {
+		req.Players = append(req.Players, models.AllInPlayer{
+			HoleCards: []string{deck[2*i].String(), deck[2*i+1].String()},
+			Committed: 100,
+		})
+	}
+	for _, c := range deck[20:50] {
+		req.DeadCards = append(req.DeadCards, c.String())
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := postJSON(NewHandler(LoadConfig()).HandleAllIn, string(body))
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusBadRequest || resp.Code != models.CodeTooManyOpponents {
+		t.Errorf("status %d code %q, want 400 %q: %s", w.Code, resp.Code, models.CodeTooManyOpponents, resp.Error)
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleBatchEvaluate evaluates many hold'em hands in one request. Batches
+// above MaxBatchSize are rejected up front. A batch still running after
+// BatchTimeout fails as a whole with TIMEOUT and no partial results, so a
+// client never mistakes a truncated batch for a complete one.
+func (h *Handler) HandleBatchEvaluate(c *gin.Context) {
+	var req models.BatchEvaluateRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if (req.Hands == nil) == (req.IDs == nil) {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request: provide exactly one of hands or ids")
+		return
+	}
+
+	count := len(req.Hands) + len(req.IDs)
+	if count > h.config.MaxBatchSize {
+		writeError(c, http.StatusBadRequest, models.CodeBatchTooLarge,
+			fmt.Sprintf("Batch cannot exceed %d hands, got %d", h.config.MaxBatchSize, count))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.config.BatchTimeout)
+	defer cancel()
+
+	results := make([]models.BatchHandResult, count)
+	for i := range results {
+		if ctx.Err() != nil {
+			writeError(c, http.StatusServiceUnavailable, models.CodeTimeout,
+				fmt.Sprintf("Batch timed out after %s with %d of %d hands evaluated", h.config.BatchTimeout, i, count))
+			return
+		}
+		if req.IDs != nil {
+			results[i] = h.evaluateBatchIDs(req.IDs[i], req.Locale)
+		} else {
+			results[i] = h.evaluateBatchHand(req.Hands[i], req.Locale)
+		}
+	}
+
+	c.JSON(http.StatusOK, models.BatchEvaluateResponse{Results: results})
+}
+
+// evaluateBatchHand evaluates one batch hand, reporting invalid cards in
+// the result instead of failing the batch.
+func (h *Handler) evaluateBatchHand(codes []string, locale string) models.BatchHandResult {
+	if len(codes) < 1 || len(codes) > 7 {
+		return models.BatchHandResult{
+			Error: fmt.Sprintf("Invalid cards: must provide 1-7 cards, got %d", len(codes)),
+			Code:  models.CodeInvalidCardCount,
+		}
+	}
+
+	cards, err := parseUniqueCards(codes)
+	if err != nil {
+		return models.BatchHandResult{Error: "Invalid cards: " + err.Error(), Code: cardErrorCode(err)}
+	}
+
+	result, err := h.engine.Evaluate(cards)
+	if err != nil {
+		return models.BatchHandResult{Error: "Invalid cards: " + err.Error(), Code: cardErrorCode(err)}
+	}
+	return models.BatchHandResult{
+		Hand: evaluator.HandRankName(result.Rank, locale),
+		Rank: int(result.Rank),
+	}
+}
+
+// evaluateBatchIDs evaluates one batch hand given as a card ID list,
+// reporting an unreadable list in the result like any other invalid hand.
+func (h *Handler) evaluateBatchIDs(list, locale string) models.BatchHandResult {
+	cards, err := card.ParseIDList(list)
+	if err != nil {
+		return models.BatchHandResult{Error: "Invalid card ids: " + err.Error(), Code: models.CodeInvalidCard}
+	}
+	return h.evaluateBatchHand(cardCodes(cards), locale)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func init() {
+	// Validation errors name fields as clients send them, by JSON key.
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// bindJSON decodes and validates the request body into req. When that
+// fails it responds 400 with a message telling apart malformed JSON, a
+// value of the wrong type, and a missing or out-of-range field, and
+// reports false so the handler returns.
+func bindJSON(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		code, message := bindError(err)
+		writeError(c, http.StatusBadRequest, code, message)
+		return false
+	}
+	return true
+}
+
+// bindError returns the error code and message for a binding error.
+func bindError(err error) (string, string) {
+	var syntax *json.SyntaxError
+	var mismatch *json.UnmarshalTypeError
+	var invalid validator.ValidationErrors
+	switch {
+	case errors.Is(err, io.EOF):
+		return models.CodeMalformedJSON, "Request body is empty"
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return models.CodeMalformedJSON, "Malformed JSON: the body ends before the JSON does"
+	case errors.As(err, &syntax):
+		return models.CodeMalformedJSON, fmt.Sprintf("Malformed JSON at byte %d: %s", syntax.Offset, syntax)
+	case errors.As(err, &mismatch):
+		return models.CodeInvalidRequest, fmt.Sprintf("Invalid request: %s must be %s, got %s",
+			mismatch.Field, jsonType(mismatch.Type), mismatch.Value)
+	case errors.As(err, &invalid):
+		messages := make([]string, len(invalid))
+		for i, field := range invalid {
+			messages[i] = fieldError(field)
+		}
+		return models.CodeInvalidRequest, "Invalid request: " + strings.Join(messages, "; ")
+	default:
+		return models.CodeInvalidRequest, "Invalid request: " + err.Error()
+	}
+}
+
+// fieldError describes one failed validation rule in terms of the JSON
+// field.
+func fieldError(field validator.FieldError) string {
+	name := field.Field()
+	// Rules on lists and strings bound their length rather than value.
+	length := ""
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		length = " items"
+	case reflect.String:
+		length = " characters"
+	}
+
+	switch field.Tag() {
+	case "required":
+		return name + " is required"
+	case "min", "gte":
+		if length != "" {
+			return fmt.Sprintf("%s must hold at least %s%s", name, field.Param(), length)
+		}
+		return fmt.Sprintf("%s must be at least %s", name, field.Param())
+	case "max", "lte":
+		if length != "" {
+			return fmt.Sprintf("%s must hold at most %s%s", name, field.Param(), length)
+		}
+		return fmt.Sprintf("%s must be at most %s", name, field.Param())
+	case "gt":
+		return fmt.Sprintf("%s must be greater than %s", name, field.Param())
+	case "lt":
+		return fmt.Sprintf("%s must be less than %s", name, field.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", name, field.Param())
+	default:
+		return fmt.Sprintf("%s failed the %s rule", name, field.Tag())
+	}
+}
+
+// jsonType names the JSON type that decodes into t, with an article.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
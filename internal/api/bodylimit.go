@@ -0,0 +1,54 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// MaxBodySize returns middleware that rejects request bodies larger than
+// limit bytes with 413 BODY_TOO_LARGE before any handler runs. A declared
+// Content-Length over the limit is refused without reading; otherwise the
+// body is read through http.MaxBytesReader, so no more than limit bytes are
+// ever held, and handed on to the handler from memory. Reading up front
+// gives every endpoint the same 413 instead of the binding error a
+// truncated body would cause mid-decode.
+func MaxBodySize(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+		if c.Request.ContentLength > limit {
+			abortBodyTooLarge(c, limit)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limit))
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			abortBodyTooLarge(c, limit)
+			return
+		case err != nil:
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Failed to read request body: "+err.Error())
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+// abortBodyTooLarge responds with 413 and stops the handler chain.
+func abortBodyTooLarge(c *gin.Context, limit int64) {
+	writeError(c, http.StatusRequestEntityTooLarge, models.CodeBodyTooLarge,
+		fmt.Sprintf("Request body cannot exceed %d bytes", limit))
+	c.Abort()
+}
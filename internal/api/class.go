@@ -0,0 +1,44 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleHandClass reports the strength class, 1-7462, of the best 5-card
+// hand among 5-7 cards.
+func (h *Handler) HandleHandClass(c *gin.Context) {
+	var req models.HandClassRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if len(req.Cards) < 5 || len(req.Cards) > 7 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+			fmt.Sprintf("Invalid cards: must provide 5-7 cards, got %d", len(req.Cards)))
+		return
+	}
+
+	cards, err := parseUniqueCards(req.Cards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+		return
+	}
+
+	result, err := h.engine.Evaluate(cards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, models.HandClassResponse{
+		Class: result.Class(),
+		Hand:  result.Label,
+		Rank:  int(result.Rank),
+		Cards: cardCodes(result.Cards),
+	})
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleCombinationCount reports how many combinations an exact
+// enumeration of an odds request would evaluate, without running it, so a
+// client can choose between exact and sampled odds.
+func (h *Handler) HandleCombinationCount(c *gin.Context) {
+	var req models.OddsRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	params, ok := h.oddsParams(c, req, h.config.MaxSimulations)
+	if !ok {
+		return
+	}
+
+	count, err := h.engine.CountCombinations(params)
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, models.CombinationCountResponse{
+		Runouts:         count.Runouts,
+		OpponentDeals:   count.OpponentDeals,
+		Combinations:    count.Combinations,
+		Enumerable:      count.Enumerable,
+		WithinLimit:     count.WithinLimit,
+		MaxCombinations: h.config.MaxSimulations,
+	})
+}
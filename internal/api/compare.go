@@ -0,0 +1,182 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleCompareHands evaluates two hands and explains which one wins.
+func (h *Handler) HandleCompareHands(c *gin.Context) {
+	var req models.CompareHandsRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	hands := [][]string{req.HandA, req.HandB}
+	results := make([]*evaluator.HandResult, len(hands))
+	for i, codes := range hands {
+		name := []string{"hand_a", "hand_b"}[i]
+		if len(codes) < 5 || len(codes) > 7 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+				fmt.Sprintf("Invalid %s: must provide 5-7 cards, got %d", name, len(codes)))
+			return
+		}
+
+		cards, err := parseUniqueCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), fmt.Sprintf("Invalid %s: %s", name, err))
+			return
+		}
+		results[i], err = h.engine.Evaluate(cards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), fmt.Sprintf("Invalid %s: %s", name, err))
+			return
+		}
+	}
+
+	tieBreak := results[0].TieBreak(results[1])
+	comparison := tieBreak.Result
+	winner := "tie"
+	if comparison > 0 {
+		winner = "a"
+	} else if comparison < 0 {
+		winner = "b"
+	}
+
+	c.JSON(http.StatusOK, models.CompareHandsResponse{
+		HandA:       results[0].Label,
+		HandB:       results[1].Label,
+		Result:      comparison,
+		Winner:      winner,
+		Explanation: evaluator.ExplainCompare(results[0], results[1]),
+		TieBreak: models.TieBreak{
+			DecidedBy:   tieBreak.DecidedBy,
+			Position:    tieBreak.Position,
+			WinningCard: tieBreak.WinningCard,
+			LosingCard:  tieBreak.LosingCard,
+		},
+	})
+}
+
+// HandleCompareEquity calculates the equity of two candidate hero hands in
+// the same spot and reports which is better, or that they are statistically
+// tied when the difference is within its confidence interval.
+func (h *Handler) HandleCompareEquity(c *gin.Context) {
+	var req models.CompareEquityRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+	if len(boardCards) > 5 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Board cannot have more than 5 cards")
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	hands := [][]string{req.HandA, req.HandB}
+	holeCards := make([][]*card.Card, len(hands))
+	for i, codes := range hands {
+		name := []string{"hand_a", "hand_b"}[i]
+
+		holeCards[i], err = card.ParseCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), fmt.Sprintf("Invalid %s: %s", name, err))
+			return
+		}
+		if len(holeCards[i]) != 2 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+				fmt.Sprintf("Invalid %s: must provide exactly 2 hole cards", name))
+			return
+		}
+
+		known := append(append(append([]*card.Card{}, holeCards[i]...), boardCards...), deadCards...)
+		if err := card.CheckUnique(known); err != nil {
+			writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, fmt.Sprintf("Invalid %s: %s", name, err))
+			return
+		}
+	}
+
+	// Both hands play the same simulations, so the difference is measured
+	// on paired samples and its interval excludes their shared noise.
+	result, err := h.engine.RankHands(simulator.RankParams{
+		Hands:        holeCards,
+		BoardCards:   boardCards,
+		NumOpponents: req.NumOpponents,
+		DeadCards:    deadCards,
+		Simulations:  req.Simulations,
+		Workers:      req.Workers,
+		Seed:         (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	results := make([]simulator.RankedHand, len(hands))
+	for _, hand := range result.Hands {
+		results[hand.Index] = hand
+	}
+
+	comparison := result.Compare(0, 1)
+
+	better := "tie"
+	summary := fmt.Sprintf("Statistically tied: the %.1f%% difference is within ±%.1f%%",
+		math.Abs(comparison.Difference)*100, comparison.Margin*100)
+	if !comparison.StatisticallyTied {
+		better = "a"
+		if comparison.Difference < 0 {
+			better = "b"
+		}
+		summary = fmt.Sprintf("Hand %s is better by %.1f%% (±%.1f%%)", strings.ToUpper(better),
+			math.Abs(comparison.Difference)*100, comparison.Margin*100)
+	}
+
+	c.JSON(http.StatusOK, models.CompareEquityResponse{
+		HandA:             handEquity(req.HandA, results[0]),
+		HandB:             handEquity(req.HandB, results[1]),
+		Difference:        comparison.Difference,
+		StandardError:     comparison.StandardError,
+		StatisticallyTied: comparison.StatisticallyTied,
+		Better:            better,
+		Summary:           summary,
+	})
+}
+
+// handEquity converts one hand's odds to its response form.
+func handEquity(holeCards []string, hand simulator.RankedHand) models.HandEquity {
+	return models.HandEquity{
+		HoleCards:     holeCards,
+		Win:           hand.Win,
+		Tie:           hand.Tie,
+		Loss:          hand.Loss,
+		Equity:        hand.PotShare,
+		StandardError: hand.StandardError,
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+)
+
+// Config holds server settings read from the environment at startup.
+type Config struct {
+	// DefaultSimulations is used when an odds request omits simulations.
+	DefaultSimulations int
+	// DefaultWorkers is used when an odds request omits workers.
+	DefaultWorkers int
+	// MaxParallel, when positive, bounds the simulation workers running
+	// at once across all requests.
+	MaxParallel int
+	// MaxSimulations caps the simulations a single odds request may ask for.
+	MaxSimulations int
+	// GzipMinSize is the smallest response in bytes that is gzip-compressed.
+	GzipMinSize int
+	// MaxBodyBytes caps the size of a request body.
+	MaxBodyBytes int
+	// MaxBatchSize caps the hands a single batch evaluate request may hold.
+	MaxBatchSize int
+	// BatchTimeout bounds the time spent evaluating one batch.
+	BatchTimeout time.Duration
+	// MaxJobSimulations caps the simulations of a single background job.
+	MaxJobSimulations int
+	// MaxJobs caps the background jobs running at once.
+	MaxJobs int
+	// JobTTL bounds how long a job may run, and how long its result is
+	// kept once it finishes.
+	JobTTL time.Duration
+	// DeferWarmUp skips building the evaluator's lookup tables at startup,
+	// leaving them to the first request that needs them.
+	DeferWarmUp bool
+	// EvalCache enables the evaluator's cache of 5-card evaluations.
+	EvalCache bool
+	// DisableCORS leaves the CORS middleware out of the router, for
+	// deployments behind a gateway that sets the CORS headers itself.
+	DisableCORS bool
+	// LogLevel selects the request log: LogFull logs every request,
+	// LogErrors only those answered with an error status, and LogSilent
+	// none.
+	LogLevel string
+}
+
+// Request log levels accepted in Config.LogLevel.
+const (
+	LogFull   = "full"
+	LogErrors = "errors"
+	LogSilent = "silent"
+)
+
+// LoadConfig reads the server configuration from environment variables,
+// falling back to built-in defaults for unset or invalid values.
+func LoadConfig() Config {
+	return Config{
+		DefaultSimulations: envInt("DEFAULT_SIMULATIONS", 10000),
+		DefaultWorkers:     envInt("DEFAULT_WORKERS", simulator.EffectiveCPUs()),
+		MaxParallel:        envInt("MAX_PARALLEL", 0),
+		MaxSimulations:     envInt("MAX_SIMULATIONS", 1000000),
+		GzipMinSize:        envInt("GZIP_MIN_SIZE", 1024),
+		MaxBodyBytes:       envInt("MAX_BODY_BYTES", 1<<20),
+		MaxBatchSize:       envInt("MAX_BATCH_SIZE", 10000),
+		BatchTimeout:       time.Duration(envInt("BATCH_TIMEOUT_MS", 5000)) * time.Millisecond,
+		MaxJobSimulations:  envInt("MAX_JOB_SIMULATIONS", 100000000),
+		MaxJobs:            envInt("MAX_JOBS", 4),
+		JobTTL:             time.Duration(envInt("JOB_TTL_SECONDS", 600)) * time.Second,
+		DeferWarmUp:        envBool("DEFER_WARM_UP", false),
+		EvalCache:          envBool("EVAL_CACHE", true),
+		DisableCORS:        envBool("DISABLE_CORS", false),
+		LogLevel:           envChoice("LOG_LEVEL", LogFull, LogErrors, LogSilent),
+	}
+}
+
+// envInt reads a positive integer from the environment.
+func envInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		log.Printf("Ignoring invalid %s=%q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
+
+// envBool reads a boolean such as "true" or "1" from the environment.
+func envBool(key string, fallback bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Ignoring invalid %s=%q, using %t", key, value, fallback)
+		return fallback
+	}
+	return b
+}
+
+// envChoice reads one of a fixed set of values from the environment,
+// case-insensitively. fallback is the default and one of the choices.
+func envChoice(key, fallback string, others ...string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	for _, choice := range append([]string{fallback}, others...) {
+		if strings.EqualFold(value, choice) {
+			return choice
+		}
+	}
+	log.Printf("Ignoring invalid %s=%q, using %s", key, value, fallback)
+	return fallback
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleDeal deals a complete random hand, for demos and test data, and
+// reports every player's hand and the showdown winners.
+func (h *Handler) HandleDeal(c *gin.Context) {
+	var req models.DealRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	holeCards := make([][]*card.Card, len(req.HoleCards))
+	for i, codes := range req.HoleCards {
+		var err error
+		holeCards[i], err = card.ParseCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err),
+				fmt.Sprintf("Invalid player %d hole cards: %s", i+1, err))
+			return
+		}
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+
+	result, err := h.engine.Deal(simulator.DealParams{
+		Players:    req.Players,
+		HoleCards:  holeCards,
+		BoardCards: boardCards,
+		Seed:       (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	players := make([]models.DealtPlayer, len(result.Players))
+	for i, player := range result.Players {
+		players[i] = models.DealtPlayer{
+			HoleCards: cardCodes(player.HoleCards),
+			Hand:      player.Hand.Label,
+			Rank:      int(player.Hand.Rank),
+			Cards:     cardCodes(player.Hand.Cards),
+		}
+	}
+
+	c.JSON(http.StatusOK, models.DealResponse{
+		Players:    players,
+		BoardCards: cardCodes(result.BoardCards),
+		Winners:    result.Winners,
+	})
+}
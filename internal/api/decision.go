@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleDecision runs an odds calculation for a hero facing a bet and
+// recommends calling or folding by comparing the hero's equity with the
+// pot odds.
+func (h *Handler) HandleDecision(c *gin.Context) {
+	var req models.DecisionRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	params, ok := h.oddsParams(c, req.OddsRequest, h.config.MaxSimulations)
+	if !ok {
+		return
+	}
+	result, err := h.runOdds(c.Request.Context(), req.OddsRequest, params)
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	decision := simulator.Decide(result, req.Pot, req.Bet)
+	response := models.DecisionResponse{
+		RequiredEquity: decision.RequiredEquity,
+		Equity:         decision.Equity,
+		EquityMargin:   decision.EquityMargin,
+		CallEV:         decision.CallEV,
+		Action:         decision.Action,
+		Close:          decision.Close,
+		Simulations:    result.Simulations,
+	}
+	formatDecision(&response, req.Format)
+	c.JSON(http.StatusOK, response)
+}
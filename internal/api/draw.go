@@ -0,0 +1,69 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleDrawOdds calculates five-card draw odds for the hero's hand after
+// an optional draw, against opponents holding random five-card hands.
+func (h *Handler) HandleDrawOdds(c *gin.Context) {
+	var req models.DrawOddsRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	if len(req.HoleCards) != 5 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Five-card draw requires exactly 5 hole cards")
+		return
+	}
+
+	holeCards, err := card.ParseCards(req.HoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	result, err := h.engine.DrawOdds(simulator.DrawParams{
+		HoleCards:    holeCards,
+		Discards:     req.Discards,
+		NumOpponents: req.NumOpponents,
+		DeadCards:    deadCards,
+		Simulations:  req.Simulations,
+		Workers:      req.Workers,
+		Seed:         (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, models.DrawOddsResponse{
+		Win:                     result.Win,
+		Tie:                     result.Tie,
+		Loss:                    result.Loss,
+		PotShare:                result.PotShare,
+		Simulations:             result.Simulations,
+		StandardError:           result.StandardError,
+		WinningHandDistribution: result.WinningHandDistribution,
+	})
+}
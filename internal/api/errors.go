@@ -0,0 +1,71 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// writeError responds with an ErrorResponse carrying a stable error code.
+func writeError(c *gin.Context, status int, code, message string) {
+	c.JSON(status, models.ErrorResponse{
+		Error: message,
+		Code:  code,
+	})
+}
+
+// cardErrorCode returns the error code for a card parsing or evaluation
+// error.
+func cardErrorCode(err error) string {
+	switch {
+	case errors.Is(err, card.ErrDuplicateCard):
+		return models.CodeDuplicateCard
+	case errors.Is(err, evaluator.ErrInvalidHand):
+		return models.CodeInvalidCardCount
+	default:
+		return models.CodeInvalidCard
+	}
+}
+
+// oddsErrorStatus maps an engine error to an HTTP status and error code.
+func oddsErrorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, card.ErrDuplicateCard):
+		return http.StatusBadRequest, models.CodeDuplicateCard
+	case errors.Is(err, simulator.ErrInvalidCards):
+		return http.StatusBadRequest, models.CodeInvalidCardCount
+	case errors.Is(err, simulator.ErrInsufficientCards):
+		return http.StatusBadRequest, models.CodeTooManyOpponents
+	case errors.Is(err, simulator.ErrEmptyRange):
+		return http.StatusBadRequest, models.CodeInvalidRange
+	case errors.Is(err, simulator.ErrOpponentHands):
+		return http.StatusBadRequest, models.CodeInvalidCardCount
+	case errors.Is(err, simulator.ErrNoOpponents):
+		return http.StatusBadRequest, models.CodeInvalidRequest
+	case errors.Is(err, simulator.ErrAllInPlayers):
+		return http.StatusBadRequest, models.CodeInvalidCardCount
+	case errors.Is(err, simulator.ErrMultipleBoards):
+		return http.StatusBadRequest, models.CodeInvalidRequest
+	case errors.Is(err, simulator.ErrRangeConflict):
+		return http.StatusBadRequest, models.CodeRangeConflict
+	case errors.Is(err, simulator.ErrHoleUse):
+		return http.StatusBadRequest, models.CodeInvalidRequest
+	case errors.Is(err, simulator.ErrNotEnumerable):
+		return http.StatusBadRequest, models.CodeInvalidRequest
+	case errors.Is(err, simulator.ErrFoldFrequency):
+		return http.StatusBadRequest, models.CodeInvalidRequest
+	case errors.Is(err, simulator.ErrRangeFilter):
+		return http.StatusBadRequest, models.CodeInvalidRange
+	case errors.Is(err, simulator.ErrNoSimulations):
+		return http.StatusInternalServerError, models.CodeNoSimulations
+	case errors.Is(err, simulator.ErrTooManyRunouts):
+		return http.StatusBadRequest, models.CodeSimulationCapExceeded
+	default:
+		return http.StatusInternalServerError, models.CodeInternal
+	}
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+)
+
+// An engine configured to run no simulations is the server's fault, so
+// it maps to a 500 rather than a client error.
+func TestOddsErrorStatusNoSimulations(t *testing.T) {
+	engine := simulator.NewEngine()
+	engine.DefaultSimulations = 0
+	_, err := engine.Odds(simulator.OddsParams{HoleCards: card.MustParse("As Ks"), NumOpponents: 1})
+	if err == nil {
+		t.Fatal("Odds ran with no simulations")
+	}
+	if status, code := oddsErrorStatus(err); status != http.StatusInternalServerError || code != models.CodeNoSimulations {
+		t.Errorf("oddsErrorStatus(%v) = %d %s, want %d %s", err, status, code, http.StatusInternalServerError, models.CodeNoSimulations)
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import "github.com/KyleKDang/poker-odds-engine/pkg/models"
+
+// formatOdds rescales the probabilities in an odds response to format.
+// Fractions are left as computed; percentages multiply every probability,
+// and the standard errors and margin with them, by 100. Variances scale
+// by the square.
+func formatOdds(response *models.OddsResponse, format string) {
+	if format != models.FormatPercent {
+		return
+	}
+
+	const scale = 100
+	for _, p := range []*float64{
+		&response.Win, &response.Tie, &response.Loss, &response.PotShare,
+		&response.StandardError, &response.Margin, &response.WorkerWinStdDev,
+		&response.FoldEquity, &response.ShowdownPotShare,
+	} {
+		*p *= scale
+	}
+	response.WorkerWinVariance *= scale * scale
+
+	// The distribution may be shared with a cached result, so it is
+	// replaced rather than scaled in place.
+	distribution := make(map[string]float64, len(response.WinningHandDistribution))
+	for hand, frequency := range response.WinningHandDistribution {
+		distribution[hand] = frequency * scale
+	}
+	response.WinningHandDistribution = distribution
+
+	for i := range response.HeadToHead {
+		matchup := &response.HeadToHead[i]
+		matchup.Win *= scale
+		matchup.Tie *= scale
+		matchup.Loss *= scale
+	}
+	for i := range response.TopLosingHands {
+		response.TopLosingHands[i].Frequency *= scale
+	}
+}
+
+// formatDecision rescales the equities in a decision response to format.
+// CallEV is in chips and keeps its scale.
+func formatDecision(response *models.DecisionResponse, format string) {
+	if format != models.FormatPercent {
+		return
+	}
+
+	const scale = 100
+	response.RequiredEquity *= scale
+	response.Equity *= scale
+	response.EquityMargin *= scale
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// gridHands is the number of starting hands in a preflop grid.
+const gridHands = 169
+
+// HandlePreflopGrid calculates the hero's preflop equity with each of the
+// 169 starting hands against one opponent.
+func (h *Handler) HandlePreflopGrid(c *gin.Context) {
+	var req models.PreflopGridRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	simulations := req.Simulations
+	if simulations < 1 {
+		simulations = simulator.DefaultGridSimulations
+	}
+	if simulations*gridHands > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations per hand cannot exceed %d", h.config.MaxSimulations/gridHands))
+		return
+	}
+
+	opponentHand, err := card.ParseCards(req.OpponentHoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid opponent hole cards: "+err.Error())
+		return
+	}
+	if len(opponentHand) != 0 && len(opponentHand) != 2 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Opponent must have exactly 2 hole cards")
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	if err := card.CheckUnique(append(append([]*card.Card{}, opponentHand...), deadCards...)); err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, "Invalid cards: "+err.Error())
+		return
+	}
+
+	var opponentRange handrange.Range
+	if req.OpponentRange != "" {
+		if len(opponentHand) > 0 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRequest,
+				"Provide either opponent_hole_cards or opponent_range, not both")
+			return
+		}
+		opponentRange, err = handrange.Parse(req.OpponentRange)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRange, "Invalid opponent range: "+err.Error())
+			return
+		}
+	}
+
+	grid, err := h.engine.PreflopGrid(simulator.GridParams{
+		OpponentHoleCards: opponentHand,
+		OpponentRange:     opponentRange,
+		WeightedRange:     req.WeightedRange,
+		DeadCards:         deadCards,
+		Simulations:       simulations,
+		Workers:           req.Workers,
+		Seed:              (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	cells := make([][]models.GridCell, len(grid))
+	for row := range grid {
+		cells[row] = make([]models.GridCell, len(grid[row]))
+		for col, cell := range grid[row] {
+			cells[row][col] = models.GridCell{Hand: cell.Hand, Equity: cell.Equity, Combos: cell.Combos}
+		}
+	}
+
+	c.JSON(http.StatusOK, models.PreflopGridResponse{Grid: cells})
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gzipWriters reuses gzip writers across responses.
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(nil) },
+}
+
+// Gzip returns middleware that gzip-compresses responses of at least
+// minSize bytes for clients that accept gzip. Smaller responses are sent
+// as-is. Event streams and responses that flush before reaching minSize
+// are passed through unbuffered so streaming is never delayed.
+func Gzip(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead ||
+			!strings.Contains(c.GetHeader("Accept-Encoding"), "gzip") {
+			c.Next()
+			return
+		}
+
+		writer := &gzipWriter{ResponseWriter: c.Writer, minSize: minSize}
+		c.Writer = writer
+		defer writer.finish()
+
+		c.Header("Vary", "Accept-Encoding")
+		c.Next()
+	}
+}
+
+// gzipWriter buffers a response until it is large enough to compress.
+type gzipWriter struct {
+	gin.ResponseWriter
+	minSize     int
+	buf         bytes.Buffer
+	gz          *gzip.Writer
+	passthrough bool
+}
+
+// Write buffers, compresses, or passes through p depending on the
+// response so far.
+func (w *gzipWriter) Write(p []byte) (int, error) {
+	switch {
+	case w.gz != nil:
+		return w.gz.Write(p)
+	case w.passthrough:
+		return w.ResponseWriter.Write(p)
+	case strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream"):
+		if err := w.startPassthrough(); err != nil {
+			return 0, err
+		}
+		return w.ResponseWriter.Write(p)
+	}
+
+	w.buf.Write(p)
+	if w.buf.Len() >= w.minSize {
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// WriteString implements gin.ResponseWriter.
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends buffered data immediately. A response flushed before it
+// reaches the size threshold is streamed uncompressed from then on.
+func (w *gzipWriter) Flush() {
+	switch {
+	case w.gz != nil:
+		w.gz.Flush()
+	case !w.passthrough:
+		if w.startPassthrough() != nil {
+			return
+		}
+	}
+	w.ResponseWriter.Flush()
+}
+
+// startGzip switches to compressed output, writing the buffered bytes.
+func (w *gzipWriter) startGzip() error {
+	header := w.Header()
+	header.Set("Content-Encoding", "gzip")
+	header.Del("Content-Length")
+
+	w.gz = gzipWriters.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+	_, err := w.gz.Write(w.buf.Bytes())
+	w.buf.Reset()
+	return err
+}
+
+// startPassthrough switches to uncompressed output, writing the buffered
+// bytes.
+func (w *gzipWriter) startPassthrough() error {
+	w.passthrough = true
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buf.Bytes())
+	w.buf.Reset()
+	return err
+}
+
+// finish completes the response once the handler returns.
+func (w *gzipWriter) finish() {
+	if w.gz != nil {
+		w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriters.Put(w.gz)
+		return
+	}
+	if !w.passthrough {
+		w.startPassthrough()
+	}
+}
@@ -2,118 +2,655 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/KyleKDang/poker-odds-engine/internal/card"
 	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
 	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/internal/version"
 	"github.com/KyleKDang/poker-odds-engine/pkg/models"
 	"github.com/gin-gonic/gin"
 )
 
-// HandleHealth returns server health status.
-func HandleHealth(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status": "ok",
-		"service": "poker-odds-engine",
+// Variants accepted by the evaluate endpoint.
+const (
+	variantHoldem = "holdem"
+	variantBadugi = "badugi"
+	variantOmaha  = "omaha"
+	variantHiLo   = "hilo"
+)
+
+// Handler serves the HTTP endpoints using a shared engine.
+type Handler struct {
+	config Config
+	engine *simulator.Engine
+	jobs   *jobRegistry
+}
+
+// NewHandler creates a Handler and its engine from the given configuration.
+func NewHandler(config Config) *Handler {
+	engine := simulator.NewEngine()
+	engine.DefaultSimulations = config.DefaultSimulations
+	engine.DefaultWorkers = config.DefaultWorkers
+	engine.MaxParallel = config.MaxParallel
+	engine.MaxRunouts = config.MaxSimulations
+
+	return &Handler{config: config, engine: engine, jobs: newJobRegistry(config.JobTTL, config.MaxJobs)}
+}
+
+// HandleHealth returns server health status and build information.
+func (h *Handler) HandleHealth(c *gin.Context) {
+	c.JSON(http.StatusOK, models.HealthResponse{
+		Status:    "ok",
+		Service:   "poker-odds-engine",
+		Version:   version.Version,
+		Commit:    version.Commit,
+		GoVersion: version.GoVersion(),
 	})
 }
 
 // HandleEvaluate evaluates a poker hand.
-func HandleEvaluate(c *gin.Context) {
+func (h *Handler) HandleEvaluate(c *gin.Context) {
 	var req models.EvaluateRequest
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid request: " + err.Error(),
-		})
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	labels, err := handLabels(req.Labels)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid labels: "+err.Error())
+		return
+	}
+
+	switch strings.ToLower(req.Variant) {
+	case "", variantHoldem:
+		h.evaluateHoldem(c, req, labels)
+	case variantBadugi:
+		h.evaluateBadugi(c, req)
+	case variantOmaha:
+		h.evaluateOmaha(c, req, labels)
+	case variantHiLo:
+		h.evaluateHiLo(c, req, labels)
+	default:
+		writeError(c, http.StatusBadRequest, models.CodeUnknownVariant, fmt.Sprintf("Unknown variant: %s", req.Variant))
+	}
+}
+
+// evaluateHoldem evaluates the best 5-card high hand.
+func (h *Handler) evaluateHoldem(c *gin.Context, req models.EvaluateRequest, labels map[evaluator.HandRank]string) {
+	var result *evaluator.HandResult
+	var all []*card.Card
+	var draws []string
+	var classification string
+	if req.Cards != nil || req.CardIDs != nil {
+		count := len(req.Cards)
+		if req.Cards == nil {
+			count = len(req.CardIDs)
+		}
+		if count > 7 {
+			writeError(c, http.StatusBadRequest, models.CodeTooManyCards,
+				fmt.Sprintf("Too many cards: at most 7, got %d", count))
+			return
+		}
+		if count < 1 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+				"Invalid cards: must provide 1-7 cards, got 0")
+			return
+		}
+
+		var cards []*card.Card
+		var err error
+		if req.Cards != nil {
+			cards, err = parseUniqueCards(req.Cards)
+		} else {
+			cards, err = parseUniqueIDs(req.CardIDs)
+		}
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+			return
+		}
+		result, err = h.engine.Evaluate(cards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+			return
+		}
+		all = cards
+
+		if len(cards) < 7 {
+			draws = evaluator.DetectDraws(cards, nil)
+		}
+	} else {
+		if req.HoleCards == nil {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Must provide cards or hole_cards")
+			return
+		}
+		if len(req.BoardCards) > 5 {
+			writeError(c, http.StatusBadRequest, models.CodeTooManyCards,
+				fmt.Sprintf("Too many board cards: at most 5, got %d", len(req.BoardCards)))
+			return
+		}
+		if total := len(req.HoleCards) + len(req.BoardCards); total > 7 {
+			writeError(c, http.StatusBadRequest, models.CodeTooManyCards,
+				fmt.Sprintf("Too many cards: hole and board cards total at most 7, got %d", total))
+			return
+		}
+
+		holeCards, err := card.ParseCards(req.HoleCards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+			return
+		}
+
+		boardCards, err := card.ParseCards(req.BoardCards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+			return
+		}
+
+		result, err = h.engine.EvaluateWithBoard(holeCards, boardCards)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+			return
+		}
+		all = append(append(all, holeCards...), boardCards...)
+		classification = evaluator.Classify(holeCards, boardCards)
+
+		if len(boardCards) < 5 {
+			draws = evaluator.DetectDraws(holeCards, boardCards)
+		}
+	}
+
+	if result == nil {
+		writeError(c, http.StatusInternalServerError, models.CodeInternal, "Unable to evaluate hand")
+		return
+	}
+
+	var excluding *models.ExcludedHand
+	if len(req.Exclude) > 0 {
+		excluded := make([]evaluator.HandRank, len(req.Exclude))
+		for i, rank := range req.Exclude {
+			excluded[i] = evaluator.HandRank(rank)
+		}
+		if fallback := evaluator.EvaluateExcluding(all, excluded...); fallback != nil {
+			excluding = &models.ExcludedHand{
+				Hand:  handLabel(fallback.Rank, req.Locale, labels),
+				Rank:  int(fallback.Rank),
+				Cards: cardCodes(fallback.Cards),
+			}
+		}
+	}
+
+	var description string
+	if req.Describe {
+		description = result.Describe()
+	}
+
+	c.JSON(http.StatusOK, models.EvaluateResponse{
+		Hand:           handLabel(result.Rank, req.Locale, labels),
+		Rank:           int(result.Rank),
+		Description:    description,
+		PlaysBoard:     result.PlaysBoard,
+		Draws:          draws,
+		Classification: classification,
+		Excluding:      excluding,
+	})
+}
+
+// evaluateBadugi evaluates a 4-card badugi hand given as cards or hole_cards.
+func (h *Handler) evaluateBadugi(c *gin.Context, req models.EvaluateRequest) {
+	codes := req.Cards
+	if codes == nil {
+		codes = req.HoleCards
+	}
+	if len(codes) > 4 || len(req.BoardCards) > 0 {
+		writeError(c, http.StatusBadRequest, models.CodeTooManyCards, "Too many cards: badugi uses exactly 4 cards and no board")
+		return
+	}
+	if len(codes) != 4 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Badugi requires exactly 4 cards and no board")
+		return
+	}
+
+	cards, err := parseUniqueCards(codes)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+		return
+	}
+
+	result := evaluator.EvaluateBadugi(cards)
+
+	c.JSON(http.StatusOK, models.EvaluateResponse{
+		Hand:  result.Label,
+		Rank:  len(result.Cards),
+		Cards: cardCodes(result.Cards),
+	})
+}
+
+// evaluateHiLo evaluates the best high hand and the best eight-or-better
+// low from the same 5-7 cards, given as cards or hole and board cards.
+func (h *Handler) evaluateHiLo(c *gin.Context, req models.EvaluateRequest, labels map[evaluator.HandRank]string) {
+	codes := req.Cards
+	if codes == nil {
+		codes = append(append([]string{}, req.HoleCards...), req.BoardCards...)
+	}
+	if len(codes) > 7 {
+		writeError(c, http.StatusBadRequest, models.CodeTooManyCards,
+			fmt.Sprintf("Too many cards: at most 7, got %d", len(codes)))
+		return
+	}
+	if len(codes) < 5 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+			fmt.Sprintf("Hi-lo requires 5-7 cards, got %d", len(codes)))
+		return
+	}
+
+	cards, err := parseUniqueCards(codes)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid cards: "+err.Error())
+		return
+	}
+
+	result := evaluator.EvaluateHiLo(cards)
+
+	var low *models.LowHand
+	if result.Low != nil {
+		low = &models.LowHand{Hand: result.Low.Label, Cards: cardCodes(result.Low.Cards)}
+	}
+
+	c.JSON(http.StatusOK, models.EvaluateResponse{
+		Hand:  handLabel(result.High.Rank, req.Locale, labels),
+		Rank:  int(result.High.Rank),
+		Cards: cardCodes(result.High.Cards),
+		Low:   low,
+	})
+}
+
+// evaluateOmaha evaluates the best high hand using exactly two of 4-6 hole
+// cards and three of 3-5 board cards.
+func (h *Handler) evaluateOmaha(c *gin.Context, req models.EvaluateRequest, labels map[evaluator.HandRank]string) {
+	if len(req.HoleCards) > 6 || len(req.BoardCards) > 5 {
+		writeError(c, http.StatusBadRequest, models.CodeTooManyCards, "Too many cards: Omaha allows at most 6 hole cards and 5 board cards")
+		return
+	}
+	if len(req.HoleCards) < 4 || len(req.BoardCards) < 3 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Omaha requires 4-6 hole cards and 3-5 board cards")
 		return
 	}
 
 	holeCards, err := card.ParseCards(req.HoleCards)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid hole cards: " + err.Error(),
-		})
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
 		return
 	}
 
 	boardCards, err := card.ParseCards(req.BoardCards)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid board cards: " + err.Error(),
-		})
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
 		return
 	}
 
-	allCards := append(holeCards, boardCards...)
-	result := evaluator.EvaluateHand(allCards)
+	if err := card.CheckUnique(append(append([]*card.Card{}, holeCards...), boardCards...)); err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, "Invalid cards: "+err.Error())
+		return
+	}
 
-	if result == nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Unable to evaluate hand",
-		})
+	result, err := evaluator.EvaluateConstrained(holeCards, boardCards, 2, 3)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, err.Error())
 		return
 	}
 
+	var description string
+	if req.Describe {
+		description = result.Describe()
+	}
+
 	c.JSON(http.StatusOK, models.EvaluateResponse{
-		Hand: result.Label,
-		Rank: int(result.Rank),
+		Hand:        handLabel(result.Rank, req.Locale, labels),
+		Rank:        int(result.Rank),
+		Description: description,
+		Cards:       cardCodes(result.Cards),
 	})
 }
 
+// handLabels resolves label overrides keyed by default English hand names,
+// matched case-insensitively, to their hand ranks.
+func handLabels(labels map[string]string) (map[evaluator.HandRank]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	ranks := make(map[string]evaluator.HandRank, len(evaluator.HandRankNames))
+	for rank, name := range evaluator.HandRankNames {
+		ranks[strings.ToLower(name)] = rank
+	}
+
+	resolved := make(map[evaluator.HandRank]string, len(labels))
+	for name, label := range labels {
+		rank, ok := ranks[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown hand %q", name)
+		}
+		if strings.TrimSpace(label) == "" {
+			return nil, fmt.Errorf("empty label for %q", name)
+		}
+		resolved[rank] = label
+	}
+	return resolved, nil
+}
+
+// handLabel names a hand rank with the request's override when it has
+// one, and in its locale otherwise. Overrides live in the request, so the
+// shared name tables are never modified.
+func handLabel(rank evaluator.HandRank, locale string, labels map[evaluator.HandRank]string) string {
+	if label, ok := labels[rank]; ok {
+		return label
+	}
+	return evaluator.HandRankName(rank, locale)
+}
+
+// parseUniqueCards parses card codes and rejects repeated cards.
+func parseUniqueCards(codes []string) ([]*card.Card, error) {
+	cards, err := card.ParseCards(codes)
+	if err != nil {
+		return nil, err
+	}
+	if err := card.CheckUnique(cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// parseUniqueIDs converts card IDs and rejects repeated cards.
+func parseUniqueIDs(ids []int) ([]*card.Card, error) {
+	cards, err := card.FromIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := card.CheckUnique(cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// cardCodes formats cards as their string codes.
+func cardCodes(cards []*card.Card) []string {
+	if len(cards) == 0 {
+		return nil
+	}
+	codes := make([]string, len(cards))
+	for i, c := range cards {
+		codes[i] = c.String()
+	}
+	return codes
+}
+
 // HandleOdds calculates winning odds using Monte Carlo simulation.
-func HandleOdds(c *gin.Context) {
+func (h *Handler) HandleOdds(c *gin.Context) {
 	var req models.OddsRequest
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid request: " + err.Error(),
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	if req.Simulations <= 0 {
-		req.Simulations = 10000
+	params, ok := h.oddsParams(c, req, h.config.MaxSimulations)
+	if !ok {
+		return
+	}
+	result, err := h.runOdds(c.Request.Context(), req, params)
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
 	}
-	if req.Workers <= 0 {
-		req.Workers = 4
+
+	c.JSON(http.StatusOK, oddsResponse(req, params, result))
+}
+
+// oddsParams validates an odds request and builds its simulator
+// parameters, allowing up to maxSimulations. On an invalid request it
+// writes the error response and returns false.
+func (h *Handler) oddsParams(c *gin.Context, req models.OddsRequest, maxSimulations int) (simulator.OddsParams, bool) {
+	if req.Simulations > maxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", maxSimulations))
+		return simulator.OddsParams{}, false
 	}
 
 	holeCards, err := card.ParseCards(req.HoleCards)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid hole cards: " + err.Error(),
-		})
-		return
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return simulator.OddsParams{}, false
 	}
 
 	boardCards, err := card.ParseCards(req.BoardCards)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid board cards: " + err.Error(),
-		})
-		return
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return simulator.OddsParams{}, false
 	}
 
-	if len(holeCards) != 2 {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Must provide exactly 2 hole cards",
-		})
-		return
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return simulator.OddsParams{}, false
+	}
+
+	burnedCards, err := card.ParseCards(req.BurnedCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid burned cards: "+err.Error())
+		return simulator.OddsParams{}, false
+	}
+
+	outOfPlay, err := card.ParseCards(req.KnownOutOfPlay)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid known out of play cards: "+err.Error())
+		return simulator.OddsParams{}, false
+	}
+
+	opponentHands := make([][]*card.Card, len(req.OpponentHoleCards))
+	for i, codes := range req.OpponentHoleCards {
+		opponentHands[i], err = card.ParseCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err),
+				fmt.Sprintf("Invalid opponent %d hole cards: %s", i+1, err))
+			return simulator.OddsParams{}, false
+		}
+		if req.HoleUse == 0 && (len(opponentHands[i]) < 1 || len(opponentHands[i]) > 2) {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+				fmt.Sprintf("Opponent %d must have 1 or 2 hole cards", i+1))
+			return simulator.OddsParams{}, false
+		}
+	}
+	if len(opponentHands) > req.NumOpponents {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount,
+			fmt.Sprintf("Cannot fix %d opponent hands with only %d opponents", len(opponentHands), req.NumOpponents))
+		return simulator.OddsParams{}, false
+	}
+
+	var opponentRange handrange.Range
+	if req.OpponentRange != "" {
+		opponentRange, err = handrange.Parse(req.OpponentRange)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRange, "Invalid opponent range: "+err.Error())
+			return simulator.OddsParams{}, false
+		}
+	}
+	if req.OpponentPosition != "" {
+		if req.OpponentRange != "" {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Provide either opponent_range or opponent_position, not both")
+			return simulator.OddsParams{}, false
+		}
+		opponentRange, err = handrange.OpeningRange(req.OpponentPosition, req.OpponentOpenPct)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRange, "Invalid opponent position: "+err.Error())
+			return simulator.OddsParams{}, false
+		}
+	} else if req.OpponentOpenPct > 0 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "opponent_open_pct requires opponent_position")
+		return simulator.OddsParams{}, false
+	}
+
+	var opponentFilter *simulator.RangeFilter
+	if req.OpponentFilter != nil {
+		opponentFilter = &simulator.RangeFilter{
+			MinRank:   evaluator.HandRank(req.OpponentFilter.MinRank),
+			MinPair:   req.OpponentFilter.MinPair,
+			KeepDraws: req.OpponentFilter.KeepDraws,
+		}
+	}
+
+	var heroRange handrange.Range
+	if req.HeroRange != "" {
+		if len(holeCards) > 0 {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "Provide either hole_cards or hero_range, not both")
+			return simulator.OddsParams{}, false
+		}
+		heroRange, err = handrange.Parse(req.HeroRange)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, models.CodeInvalidRange, "Invalid hero range: "+err.Error())
+			return simulator.OddsParams{}, false
+		}
+	} else if req.HoleUse == 0 && len(holeCards) != 2 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Must provide exactly 2 hole cards")
+		return simulator.OddsParams{}, false
 	}
 	if len(boardCards) > 5 {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Board cannot have more than 5 cards",
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Board cannot have more than 5 cards")
+		return simulator.OddsParams{}, false
+	}
+
+	known := append(append(append([]*card.Card{}, holeCards...), boardCards...), deadCards...)
+	known = append(append(known, burnedCards...), outOfPlay...)
+	for _, hand := range opponentHands {
+		known = append(known, hand...)
+	}
+	if err := card.CheckUnique(known); err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, "Invalid cards: "+err.Error())
+		return simulator.OddsParams{}, false
+	}
+
+	params := simulator.OddsParams{
+		HoleCards:         holeCards,
+		BoardCards:        boardCards,
+		NumOpponents:      req.NumOpponents,
+		OpponentHoleCards: opponentHands,
+		FoldedPlayers:     req.FoldedPlayers,
+		Boards:            req.Boards,
+		OpponentRange:     opponentRange,
+		OpponentFilter:    opponentFilter,
+		HeroRange:         heroRange,
+		WeightedRange:     req.WeightedRange,
+		DeadCards:         deadCards,
+		BurnedCards:       burnedCards,
+		KnownOutOfPlay:    outOfPlay,
+		TopLosingHands:    req.TopLosingHands,
+		Simulations:       req.Simulations,
+		Workers:           req.Workers,
+		Seed:              (*int64)(req.Seed),
+		TargetMargin:      req.TargetMargin,
+		ChunkSize:         req.ChunkSize,
+		HoleUse:           req.HoleUse,
+		IgnoreKickers:     req.IgnoreKickers,
+		Perspective:       req.Perspective,
+		FoldFrequency:     req.FoldFrequency,
+	}
+	// A target margin without a simulation limit may use up to the cap.
+	if req.TargetMargin > 0 && req.Simulations == 0 {
+		params.Simulations = maxSimulations
+	}
+	return params, true
+}
+
+// runOdds runs the calculation an odds request asks for, stopping early
+// when ctx is done. Exact calculations are bounded by MaxRunouts instead
+// and always run to completion.
+func (h *Handler) runOdds(ctx context.Context, req models.OddsRequest, params simulator.OddsParams) (*simulator.OddsResult, error) {
+	if req.Exact {
+		return h.engine.ExactOdds(params)
+	}
+	return h.engine.OddsContext(ctx, params)
+}
+
+// oddsResponse builds the response to an odds request from its result.
+func oddsResponse(req models.OddsRequest, params simulator.OddsParams, result *simulator.OddsResult) models.OddsResponse {
+	var headToHead []models.HeadToHead
+	for i, matchup := range result.HeadToHead {
+		// From an opponent's perspective, the hero sits in their place.
+		holeCards := req.OpponentHoleCards[i]
+		if i == req.Perspective-1 {
+			holeCards = req.HoleCards
+		}
+		headToHead = append(headToHead, models.HeadToHead{
+			HoleCards: holeCards,
+			Win:       matchup.Win,
+			Tie:       matchup.Tie,
+			Loss:      matchup.Loss,
+			Matchup:   matchup.Matchup(),
 		})
-		return
 	}
-	
-	result := simulator.CalculateOdds(holeCards, boardCards, req.NumOpponents, req.Simulations, req.Workers)
 
-	c.JSON(http.StatusOK, models.OddsResponse{
-		Win:  result.Win,
-		Tie:  result.Tie,
-		Loss: result.Loss,
-	})
+	// The made hand and draws are those of the seat the results are for:
+	// the hero, or the chosen opponent once that hand is complete.
+	holeCards := params.HoleCards
+	if params.Perspective > 0 {
+		holeCards = params.OpponentHoleCards[params.Perspective-1]
+	}
+
+	var draws []string
+	var currentHand string
+	var currentRank int
+	if params.HeroRange == nil && req.HoleUse == 0 && len(holeCards) == 2 {
+		if len(params.BoardCards) < 5 {
+			draws = evaluator.DetectDraws(holeCards, params.BoardCards)
+		}
+		made := evaluator.EvaluateWithBoard(holeCards, params.BoardCards)
+		currentHand, currentRank = made.Label, int(made.Rank)
+	}
+
+	var losingHands []models.HoldingFrequency
+	for _, holding := range result.TopLosingHands {
+		losingHands = append(losingHands, models.HoldingFrequency{
+			HoleCards: cardCodes(holding.HoleCards),
+			Frequency: holding.Frequency,
+		})
+	}
+
+	response := models.OddsResponse{
+		Win:                     result.Win,
+		Tie:                     result.Tie,
+		Loss:                    result.Loss,
+		PotShare:                result.PotShare,
+		Summary:                 result.Summary(),
+		CurrentHand:             currentHand,
+		CurrentRank:             currentRank,
+		Draws:                   draws,
+		Simulations:             result.Simulations,
+		StandardError:           result.StandardError,
+		Margin:                  result.Margin,
+		WorkerWinVariance:       result.WorkerWinVariance,
+		WorkerWinStdDev:         result.WorkerWinStdDev,
+		WinningHandDistribution: result.WinningHandDistribution,
+		HeadToHead:              headToHead,
+		TopLosingHands:          losingHands,
+		Classes:                 result.Classes,
+		FoldEquity:              result.FoldEquity,
+		ShowdownPotShare:        result.ShowdownPotShare,
+		Cached:                  result.Cached,
+		Diagnostics: models.OddsDiagnostics{
+			DeadCards:      cardCodes(result.Diagnostics.DeadCards),
+			BurnedCards:    cardCodes(result.Diagnostics.BurnedCards),
+			KnownOutOfPlay: cardCodes(result.Diagnostics.KnownOutOfPlay),
+			DeckSize:       result.Diagnostics.DeckSize,
+		},
+	}
+	if req.SuggestMargin > 0 {
+		response.SuggestedSimulations = result.SuggestedSimulations(req.SuggestMargin)
+	}
+	formatOdds(&response, req.Format)
+	return response
 }
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// postJSON sends body to handle as a POST request and returns the
+// response.
+func postJSON(handle gin.HandlerFunc, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/", handle)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestEvaluateTooManyCards(t *testing.T) {
+	handler := NewHandler(LoadConfig())
+	tests := []struct {
+		name string
+		body string
+		code string
+	}{
+		{"six-card board", `{"hole_cards": ["AS", "KS"], "board_cards": ["QS", "JS", "TS", "9S", "8S", "7S"]}`, models.CodeTooManyCards},
+		{"eight hole and board cards", `{"hole_cards": ["AS", "KS", "2D"], "board_cards": ["QS", "JS", "TS", "9S", "8S"]}`, models.CodeTooManyCards},
+		{"eight cards", `{"cards": ["AS", "KS", "QS", "JS", "TS", "9S", "8S", "7S"]}`, models.CodeTooManyCards},
+		{"omaha six-card board", `{"variant": "omaha", "hole_cards": ["AS", "KS", "QD", "JD"], "board_cards": ["2C", "3C", "4C", "5C", "7D", "8D"]}`, models.CodeTooManyCards},
+		{"badugi board", `{"variant": "badugi", "hole_cards": ["AS", "2H", "3D", "4C"], "board_cards": ["5S"]}`, models.CodeTooManyCards},
+		{"five-card board", `{"hole_cards": ["AS", "KS"], "board_cards": ["QS", "JS", "TS", "9S", "8S"]}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postJSON(handler.HandleEvaluate, tt.body)
+			if tt.code == "" {
+				if w.Code != http.StatusOK {
+					t.Fatalf("status %d, want 200: %s", w.Code, w.Body)
+				}
+				return
+			}
+
+			var resp models.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if w.Code != http.StatusBadRequest || resp.Code != tt.code {
+				t.Errorf("status %d code %q, want 400 %q: %s", w.Code, resp.Code, tt.code, resp.Error)
+			}
+		})
+	}
+}
+
+// TestOddsRejectsNonFiniteWeights covers weights that parse as floats but
+// would poison a weighted range's cumulative total.
+func TestOddsRejectsNonFiniteWeights(t *testing.T) {
+	handler := NewHandler(LoadConfig())
+	for _, weight := range []string{"NaN", "Inf", "-Inf"} {
+		body := `{"hole_cards": ["AS", "AH"], "num_opponents": 1, "simulations": 100, "weighted_range": true, "opponent_range": "AKo:` + weight + `"}`
+		w := postJSON(handler.HandleOdds, body)
+
+		var resp models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if w.Code != http.StatusBadRequest || resp.Code != models.CodeInvalidRange {
+			t.Errorf("weight %s: status %d code %q, want 400 %q: %s", weight, w.Code, resp.Code, models.CodeInvalidRange, resp.Error)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// errTooManyJobs means the registry is already running its limit of jobs.
+var errTooManyJobs = errors.New("too many running jobs")
+
+// job is one background calculation. Its fields are guarded by the
+// registry's mutex.
+type job struct {
+	id     string
+	cancel context.CancelFunc
+	status string
+	result *models.OddsResponse
+	err    *models.ErrorResponse
+	// expires is when a finished job is forgotten; zero while running.
+	expires time.Time
+	// removed is set when the job is cancelled or discarded.
+	removed bool
+}
+
+// response returns the job as an API response.
+func (j *job) response() models.JobResponse {
+	return models.JobResponse{ID: j.id, Status: j.status, Result: j.result, Error: j.err}
+}
+
+// jobRegistry runs background jobs and keeps their results for ttl after
+// they finish. A job still running after ttl is cancelled. Expired jobs
+// are swept on each access, so the registry needs no goroutine of its own.
+// It is safe for concurrent use.
+type jobRegistry struct {
+	ttl        time.Duration
+	maxRunning int
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	running int
+}
+
+// newJobRegistry creates an empty registry.
+func newJobRegistry(ttl time.Duration, maxRunning int) *jobRegistry {
+	return &jobRegistry{ttl: ttl, maxRunning: maxRunning, jobs: make(map[string]*job)}
+}
+
+// start registers a job and runs it on its own goroutine. run receives a
+// context that is done when the job is cancelled or exceeds the ttl, and
+// returns the result or the error to report.
+func (r *jobRegistry) start(run func(ctx context.Context) (*models.OddsResponse, *models.ErrorResponse)) (models.JobResponse, error) {
+	id, err := newJobID()
+	if err != nil {
+		return models.JobResponse{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep()
+	if r.running >= r.maxRunning {
+		return models.JobResponse{}, errTooManyJobs
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.ttl)
+	j := &job{id: id, cancel: cancel, status: models.JobRunning}
+	r.jobs[id] = j
+	r.running++
+
+	go func() {
+		result, jobErr := runJob(ctx, id, run)
+		cancel()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if j.removed {
+			return
+		}
+		r.running--
+		j.status, j.result, j.err = models.JobDone, result, jobErr
+		if jobErr != nil {
+			j.status = models.JobFailed
+		}
+		j.expires = time.Now().Add(r.ttl)
+	}()
+	return j.response(), nil
+}
+
+// runJob calls run and reports a panic as an internal error. The job runs
+// on its own goroutine, where gin's recovery cannot reach, so an uncaught
+// panic would stop the server.
+func runJob(ctx context.Context, id string, run func(ctx context.Context) (*models.OddsResponse, *models.ErrorResponse)) (result *models.OddsResponse, jobErr *models.ErrorResponse) {
+	defer func() {
+		if p := recover(); p != nil {
+			log.Printf("Job %s panicked: %v\n%s", id, p, debug.Stack())
+			result = nil
+			jobErr = &models.ErrorResponse{Error: fmt.Sprintf("Job failed: %v", p), Code: models.CodeInternal}
+		}
+	}()
+	return run(ctx)
+}
+
+// get returns the job with the given ID, if it exists and has not expired.
+func (r *jobRegistry) get(id string) (models.JobResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep()
+
+	j, ok := r.jobs[id]
+	if !ok {
+		return models.JobResponse{}, false
+	}
+	return j.response(), true
+}
+
+// remove forgets the job with the given ID, cancelling it if it is still
+// running. It reports whether the job existed.
+func (r *jobRegistry) remove(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep()
+
+	j, ok := r.jobs[id]
+	if !ok {
+		return false
+	}
+	// A cancelled job stops within moments, so its slot is freed now
+	// rather than when its goroutine returns.
+	if j.expires.IsZero() {
+		r.running--
+	}
+	j.removed = true
+	j.cancel()
+	delete(r.jobs, id)
+	return true
+}
+
+// sweep forgets finished jobs whose results have expired. The caller must
+// hold r.mu.
+func (r *jobRegistry) sweep() {
+	now := time.Now()
+	for id, j := range r.jobs {
+		if !j.expires.IsZero() && now.After(j.expires) {
+			delete(r.jobs, id)
+		}
+	}
+}
+
+// newJobID returns a random 128-bit job ID in hex.
+func newJobID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// HandleSubmitJob starts an odds calculation in the background and
+// responds with its job ID at once. The body is an odds request, allowed
+// up to MaxJobSimulations instead of MaxSimulations; invalid requests fail
+// here rather than as a job.
+func (h *Handler) HandleSubmitJob(c *gin.Context) {
+	var req models.OddsRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	params, ok := h.oddsParams(c, req, h.config.MaxJobSimulations)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobs.start(func(ctx context.Context) (*models.OddsResponse, *models.ErrorResponse) {
+		result, err := h.runOdds(ctx, req, params)
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			return nil, &models.ErrorResponse{
+				Error: fmt.Sprintf("Job did not finish within %s", h.config.JobTTL),
+				Code:  models.CodeTimeout,
+			}
+		case err != nil:
+			_, code := oddsErrorStatus(err)
+			return nil, &models.ErrorResponse{Error: err.Error(), Code: code}
+		}
+		response := oddsResponse(req, params, result)
+		return &response, nil
+	})
+	switch {
+	case errors.Is(err, errTooManyJobs):
+		writeError(c, http.StatusServiceUnavailable, models.CodeTooManyJobs,
+			fmt.Sprintf("Cannot run more than %d jobs at once", h.config.MaxJobs))
+		return
+	case err != nil:
+		writeError(c, http.StatusInternalServerError, models.CodeInternal, "Failed to start job: "+err.Error())
+		return
+	}
+
+	c.Header("Location", "/jobs/"+job.ID)
+	c.JSON(http.StatusAccepted, job)
+}
+
+// HandleGetJob returns a job's status, with its result once it is done.
+func (h *Handler) HandleGetJob(c *gin.Context) {
+	job, ok := h.jobs.get(c.Param("id"))
+	if !ok {
+		writeError(c, http.StatusNotFound, models.CodeJobNotFound, "Job not found: "+c.Param("id"))
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// HandleCancelJob cancels a running job, or discards a finished job's
+// result.
+func (h *Handler) HandleCancelJob(c *gin.Context) {
+	if !h.jobs.remove(c.Param("id")) {
+		writeError(c, http.StatusNotFound, models.CodeJobNotFound, "Job not found: "+c.Param("id"))
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
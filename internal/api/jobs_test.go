@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// jobRouter routes the job endpoints to h.
+func jobRouter(h *Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/jobs", h.HandleSubmitJob)
+	router.GET("/jobs/:id", h.HandleGetJob)
+	router.DELETE("/jobs/:id", h.HandleCancelJob)
+	return router
+}
+
+// serve sends a request to router and returns the response.
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// waitJob polls r until the job with the given ID stops running.
+func waitJob(t *testing.T, r *jobRegistry, id string) models.JobResponse {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, ok := r.get(id)
+		if !ok {
+			t.Fatalf("job %s not found", id)
+		}
+		if job.Status != models.JobRunning {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s still running", id)
+	return models.JobResponse{}
+}
+
+// blockingJob runs until its context is done.
+func blockingJob(ctx context.Context) (*models.OddsResponse, *models.ErrorResponse) {
+	<-ctx.Done()
+	return nil, &models.ErrorResponse{Error: ctx.Err().Error(), Code: models.CodeTimeout}
+}
+
+func TestJobSubmitPollDone(t *testing.T) {
+	h := NewHandler(LoadConfig())
+	router := jobRouter(h)
+
+	w := serve(router, http.MethodPost, "/jobs", `{"hole_cards": ["AS", "AH"], "num_opponents": 1, "simulations": 1000, "seed": 1}`)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("submit: status %d, want 202: %s", w.Code, w.Body)
+	}
+	var job models.JobResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &job); err != nil {
+		t.Fatal(err)
+	}
+	if loc := w.Header().Get("Location"); loc != "/jobs/"+job.ID {
+		t.Errorf("Location = %q, want /jobs/%s", loc, job.ID)
+	}
+
+	waitJob(t, h.jobs, job.ID)
+	w = serve(router, http.MethodGet, "/jobs/"+job.ID, "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("poll: status %d, want 200: %s", w.Code, w.Body)
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &job); err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != models.JobDone || job.Result == nil || job.Error != nil {
+		t.Fatalf("job = %+v, want done with a result", job)
+	}
+	if job.Result.Simulations != 1000 {
+		t.Errorf("result has %d simulations, want 1000", job.Result.Simulations)
+	}
+}
+
+func TestJobLimitAndCancel(t *testing.T) {
+	config := LoadConfig()
+	config.MaxJobs = 1
+	h := NewHandler(config)
+	router := jobRouter(h)
+	body := `{"hole_cards": ["AS", "AH"], "num_opponents": 1, "simulations": 100}`
+
+	running, err := h.jobs.start(blockingJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := serve(router, http.MethodPost, "/jobs", body)
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusServiceUnavailable || resp.Code != models.CodeTooManyJobs {
+		t.Fatalf("submit at limit: status %d code %q, want 503 %q", w.Code, resp.Code, models.CodeTooManyJobs)
+	}
+
+	if w := serve(router, http.MethodDelete, "/jobs/"+running.ID, ""); w.Code != http.StatusNoContent {
+		t.Fatalf("cancel: status %d, want 204: %s", w.Code, w.Body)
+	}
+	if w := serve(router, http.MethodGet, "/jobs/"+running.ID, ""); w.Code != http.StatusNotFound {
+		t.Errorf("poll after cancel: status %d, want 404", w.Code)
+	}
+	if w := serve(router, http.MethodDelete, "/jobs/"+running.ID, ""); w.Code != http.StatusNotFound {
+		t.Errorf("second cancel: status %d, want 404", w.Code)
+	}
+
+	w = serve(router, http.MethodPost, "/jobs", body)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("submit after cancel: status %d, want 202: %s", w.Code, w.Body)
+	}
+}
+
+func TestJobTTL(t *testing.T) {
+	const ttl = 50 * time.Millisecond
+	r := newJobRegistry(ttl, 2)
+
+	finished, err := r.start(func(context.Context) (*models.OddsResponse, *models.ErrorResponse) {
+		return &models.OddsResponse{}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	overdue, err := r.start(blockingJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job := waitJob(t, r, finished.ID); job.Status != models.JobDone {
+		t.Errorf("finished job status %q, want %q", job.Status, models.JobDone)
+	}
+	job := waitJob(t, r, overdue.ID)
+	if job.Status != models.JobFailed || job.Error == nil || job.Error.Code != models.CodeTimeout {
+		t.Errorf("overdue job = %+v, want failed with %q", job, models.CodeTimeout)
+	}
+
+	time.Sleep(2 * ttl)
+	for _, id := range []string{finished.ID, overdue.ID} {
+		if _, ok := r.get(id); ok {
+			t.Errorf("job %s still present after its ttl", id)
+		}
+	}
+	if _, err := r.start(blockingJob); err != nil {
+		t.Errorf("start after expiry: %v", err)
+	}
+}
+
+func TestJobPanic(t *testing.T) {
+	out := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(out)
+
+	r := newJobRegistry(time.Minute, 1)
+	started, err := r.start(func(context.Context) (*models.OddsResponse, *models.ErrorResponse) {
+		panic("boom")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := waitJob(t, r, started.ID)
+	if job.Status != models.JobFailed || job.Error == nil || job.Error.Code != models.CodeInternal {
+		t.Fatalf("job = %+v, want failed with %q", job, models.CodeInternal)
+	}
+	if _, err := r.start(blockingJob); errors.Is(err, errTooManyJobs) {
+		t.Error("a panicked job still holds its slot")
+	}
+}
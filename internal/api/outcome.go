@@ -0,0 +1,66 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleHandOutcomes reports the probability of each hand category the
+// hero can finish with by the river, regardless of opponents.
+func (h *Handler) HandleHandOutcomes(c *gin.Context) {
+	var req models.HandOutcomesRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	holeCards, err := card.ParseCards(req.HoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	result, err := h.engine.HandOutcomes(simulator.OutcomeParams{
+		HoleCards:   holeCards,
+		BoardCards:  boardCards,
+		DeadCards:   deadCards,
+		Exact:       req.Exact,
+		Simulations: req.Simulations,
+		Workers:     req.Workers,
+		Seed:        (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, models.HandOutcomesResponse{
+		Distribution: result.Distribution,
+		Runouts:      result.Runouts,
+		Exact:        result.Exact,
+	})
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleQuickPreflop rates two hole cards with the Chen formula and a
+// coarse strength bucket, without running any simulation.
+func (h *Handler) HandleQuickPreflop(c *gin.Context) {
+	var req models.QuickPreflopRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if len(req.HoleCards) != 2 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Must provide exactly 2 hole cards")
+		return
+	}
+
+	holeCards, err := parseUniqueCards(req.HoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return
+	}
+
+	hand, _ := evaluator.StartingHand(holeCards)
+	score, _ := evaluator.ChenScore(holeCards)
+
+	c.JSON(http.StatusOK, models.QuickPreflopResponse{
+		Hand:      hand,
+		ChenScore: score,
+		Bucket:    evaluator.PreflopBucket(score),
+	})
+}
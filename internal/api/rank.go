@@ -0,0 +1,83 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleRankHands ranks candidate hero hands by equity against the same
+// opponents, dealing every hand the same simulations so their order is
+// not an artifact of separate random runs.
+func (h *Handler) HandleRankHands(c *gin.Context) {
+	var req models.RankHandsRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	hands := make([][]*card.Card, len(req.Hands))
+	for i, codes := range req.Hands {
+		hands[i], err = card.ParseCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err), fmt.Sprintf("Invalid hand %d: %s", i+1, err))
+			return
+		}
+	}
+
+	result, err := h.engine.RankHands(simulator.RankParams{
+		Hands:        hands,
+		BoardCards:   boardCards,
+		DeadCards:    deadCards,
+		NumOpponents: req.NumOpponents,
+		Simulations:  req.Simulations,
+		Workers:      req.Workers,
+		Seed:         (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	ranked := make([]models.RankedHand, len(result.Hands))
+	for i, hand := range result.Hands {
+		ranked[i] = models.RankedHand{
+			Rank:       i + 1,
+			Index:      hand.Index,
+			HandEquity: handEquity(req.Hands[hand.Index], hand),
+		}
+		if hand.Lead != nil {
+			ranked[i].Lead = &models.EquityLead{
+				Difference:        hand.Lead.Difference,
+				StandardError:     hand.Lead.StandardError,
+				Margin:            hand.Lead.Margin,
+				StatisticallyTied: hand.Lead.StatisticallyTied,
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, models.RankHandsResponse{Hands: ranked, Simulations: result.Simulations})
+}
@@ -2,23 +2,90 @@
 package api
 
 import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
 // SetupRouter configures and returns a Gin router.
 func SetupRouter() *gin.Engine {
-	router := gin.Default()
+	cfg := LoadConfig()
+	handler := NewHandler(cfg)
+	evaluator.SetCache(cfg.EvalCache)
+	warmUp(cfg)
+
+	router := gin.New()
+	if logger := requestLogger(cfg.LogLevel); logger != nil {
+		router.Use(logger)
+	}
+	router.Use(gin.Recovery())
 
-	config := cors.DefaultConfig()
-	config.AllowAllOrigins = true
-	config.AllowMethods = []string{"GET", "POST", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept"}
-	router.Use(cors.New(config))
+	// Behind a gateway that handles CORS, a second set of headers from
+	// here would make browsers reject the response.
+	if !cfg.DisableCORS {
+		config := cors.DefaultConfig()
+		config.AllowAllOrigins = true
+		config.AllowMethods = []string{"GET", "POST", "DELETE", "OPTIONS"}
+		config.AllowHeaders = []string{"Origin", "Content-Type", "Accept"}
+		router.Use(cors.New(config))
+	}
+	router.Use(MaxBodySize(int64(cfg.MaxBodyBytes)))
+	router.Use(Gzip(cfg.GzipMinSize))
 
-	router.GET("/health", HandleHealth)
-	router.POST("/evaluate", HandleEvaluate)
-	router.POST("/odds", HandleOdds)
+	router.GET("/health", handler.HandleHealth)
+	router.POST("/evaluate", handler.HandleEvaluate)
+	router.POST("/evaluate/batch", handler.HandleBatchEvaluate)
+	router.POST("/odds", handler.HandleOdds)
+	router.POST("/simulate/trace", handler.HandleTrace)
+	router.POST("/all-in", handler.HandleAllIn)
+	router.POST("/draw-odds", handler.HandleDrawOdds)
+	router.POST("/standing", handler.HandleStanding)
+	router.POST("/hand-outcomes", handler.HandleHandOutcomes)
+	router.POST("/timeline", handler.HandleTimeline)
+	router.POST("/combinations", handler.HandleCombinationCount)
+	router.POST("/deal", handler.HandleDeal)
+	router.POST("/decision", handler.HandleDecision)
+	router.POST("/preflop-grid", handler.HandlePreflopGrid)
+	router.POST("/preflop/quick", handler.HandleQuickPreflop)
+	router.POST("/hand-class", handler.HandleHandClass)
+	router.POST("/compare-hands", handler.HandleCompareHands)
+	router.POST("/compare-equity", handler.HandleCompareEquity)
+	router.POST("/rank-hands", handler.HandleRankHands)
+	router.POST("/jobs", handler.HandleSubmitJob)
+	router.GET("/jobs/:id", handler.HandleGetJob)
+	router.DELETE("/jobs/:id", handler.HandleCancelJob)
 
 	return router
 }
+
+// warmUp builds the evaluator's lookup tables before the server takes
+// requests, unless the config defers them for a faster cold start.
+func warmUp(cfg Config) {
+	if cfg.DeferWarmUp {
+		log.Printf("Deferring lookup table warm-up to first use")
+		return
+	}
+	start := time.Now()
+	evaluator.WarmUp()
+	log.Printf("Lookup tables ready in %s", time.Since(start).Round(time.Millisecond))
+}
+
+// requestLogger returns the request logging middleware for a log level,
+// or nil when nothing is logged. Panics are still logged by the recovery
+// middleware at every level.
+func requestLogger(level string) gin.HandlerFunc {
+	switch level {
+	case LogSilent:
+		return nil
+	case LogErrors:
+		return gin.LoggerWithConfig(gin.LoggerConfig{
+			Skip: func(c *gin.Context) bool { return c.Writer.Status() < http.StatusBadRequest },
+		})
+	default:
+		return gin.Logger()
+	}
+}
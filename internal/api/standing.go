@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleStanding reports how the hero's current hand compares with every
+// possible opponent holding on the current board.
+func (h *Handler) HandleStanding(c *gin.Context) {
+	var req models.StandingRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	holeCards, err := card.ParseCards(req.HoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	if len(holeCards) != 2 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Must provide exactly 2 hole cards")
+		return
+	}
+	if len(boardCards) > 5 {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidCardCount, "Board cannot have more than 5 cards")
+		return
+	}
+
+	known := append(append(append([]*card.Card{}, holeCards...), boardCards...), deadCards...)
+	if err := card.CheckUnique(known); err != nil {
+		writeError(c, http.StatusBadRequest, models.CodeDuplicateCard, "Invalid cards: "+err.Error())
+		return
+	}
+
+	result := h.engine.Standing(holeCards, boardCards, deadCards)
+
+	c.JSON(http.StatusOK, models.StandingResponse{
+		Ahead:  result.Ahead,
+		Tied:   result.Tied,
+		Behind: result.Behind,
+		Combos: result.Combos,
+	})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/simulator"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleTimeline replays a hand, reporting the hero's equity as it stood
+// on each street and the outcome once everything is known.
+func (h *Handler) HandleTimeline(c *gin.Context) {
+	var req models.TimelineRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.Simulations > h.config.MaxSimulations {
+		writeError(c, http.StatusBadRequest, models.CodeSimulationCapExceeded,
+			fmt.Sprintf("Simulations cannot exceed %d", h.config.MaxSimulations))
+		return
+	}
+
+	holeCards, err := card.ParseCards(req.HoleCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid hole cards: "+err.Error())
+		return
+	}
+
+	boardCards, err := card.ParseCards(req.BoardCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid board cards: "+err.Error())
+		return
+	}
+
+	deadCards, err := card.ParseCards(req.DeadCards)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, cardErrorCode(err), "Invalid dead cards: "+err.Error())
+		return
+	}
+
+	opponentHands := make([][]*card.Card, len(req.OpponentHoleCards))
+	for i, codes := range req.OpponentHoleCards {
+		opponentHands[i], err = card.ParseCards(codes)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, cardErrorCode(err),
+				fmt.Sprintf("Invalid opponent %d hole cards: %s", i+1, err))
+			return
+		}
+	}
+
+	result, err := h.engine.Timeline(simulator.OddsParams{
+		HoleCards:         holeCards,
+		BoardCards:        boardCards,
+		NumOpponents:      req.NumOpponents,
+		OpponentHoleCards: opponentHands,
+		DeadCards:         deadCards,
+		Simulations:       req.Simulations,
+		Workers:           req.Workers,
+		Seed:              (*int64)(req.Seed),
+	})
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	streets := make([]models.StreetEquity, len(result.Streets))
+	for i, street := range result.Streets {
+		streets[i] = models.StreetEquity{
+			Street:   street.Street,
+			Win:      street.Win,
+			Tie:      street.Tie,
+			Loss:     street.Loss,
+			PotShare: street.PotShare,
+			Exact:    street.Exact,
+		}
+	}
+
+	c.JSON(http.StatusOK, models.TimelineResponse{Streets: streets, Outcome: result.Outcome})
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HandleTrace returns the first simulated deals of an odds calculation in
+// full: every board, every player's hole cards and best hand, and the
+// winners. With a seed they are the first deals of the same /odds run.
+func (h *Handler) HandleTrace(c *gin.Context) {
+	var req models.TraceRequest
+
+	if !bindJSON(c, &req) {
+		return
+	}
+	if req.Exact {
+		writeError(c, http.StatusBadRequest, models.CodeInvalidRequest, "exact cannot be traced; only simulations are")
+		return
+	}
+
+	params, ok := h.oddsParams(c, req.OddsRequest, h.config.MaxSimulations)
+	if !ok {
+		return
+	}
+	traces, err := h.engine.Trace(c.Request.Context(), params, req.Traces)
+	if err != nil {
+		status, code := oddsErrorStatus(err)
+		writeError(c, status, code, err.Error())
+		return
+	}
+
+	response := models.TraceResponse{Simulations: make([]models.TracedSimulation, len(traces))}
+	for i, trace := range traces {
+		showdowns := make([]models.TracedShowdown, len(trace.Showdowns))
+		for b, showdown := range trace.Showdowns {
+			players := make([]models.DealtPlayer, len(showdown.Players))
+			for p, player := range showdown.Players {
+				players[p] = models.DealtPlayer{
+					HoleCards: cardCodes(player.HoleCards),
+					Hand:      player.Hand.Label,
+					Rank:      int(player.Hand.Rank),
+					Cards:     cardCodes(player.Hand.Cards),
+				}
+			}
+			showdowns[b] = models.TracedShowdown{
+				BoardCards: cardCodes(showdown.BoardCards),
+				Players:    players,
+				Winners:    showdown.Winners,
+			}
+		}
+		response.Simulations[i] = models.TracedSimulation{
+			Simulation: trace.Simulation,
+			Showdowns:  showdowns,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,33 @@
+package card
+
+// RemainingBoards returns every way to add cardsToAdd cards to boardSoFar
+// from the cards not in known or boardSoFar. Each board starts with a copy
+// of boardSoFar followed by the added cards in ID order, and the boards
+// themselves are ordered lexicographically by those IDs. It returns nil if
+// cardsToAdd is negative or more than the cards left.
+func RemainingBoards(known []*Card, boardSoFar []*Card, cardsToAdd int) [][]*Card {
+	used := NewSet(known...).Union(NewSet(boardSoFar...))
+	remaining := FullDeck.Without(used).Cards()
+	if cardsToAdd < 0 || cardsToAdd > len(remaining) {
+		return nil
+	}
+
+	size := len(boardSoFar) + cardsToAdd
+	var boards [][]*Card
+	board := make([]*Card, size)
+	copy(board, boardSoFar)
+
+	var choose func(start, pos int)
+	choose = func(start, pos int) {
+		if pos == size {
+			boards = append(boards, append([]*Card(nil), board...))
+			return
+		}
+		for i := start; i <= len(remaining)-(size-pos); i++ {
+			board[pos] = remaining[i]
+			choose(i+1, pos+1)
+		}
+	}
+	choose(0, len(boardSoFar))
+	return boards
+}
@@ -2,8 +2,12 @@
 package card
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Rank represents a card rank (2-A).
@@ -49,9 +53,21 @@ type Card struct {
 	Suit Suit
 }
 
-// NewCard creates a card from a 2-character code (e.g., "AS").
+// NewCard creates a card from a 2-character code (e.g., "AS"). Codes are
+// case-insensitive, and "10" is accepted for a ten, as in "10H".
 func NewCard(code string) (*Card, error) {
-	code = strings.ToUpper(strings.TrimSpace(code))
+	code = strings.TrimSpace(code)
+	// Only ASCII codes are valid. Checking before upper-casing keeps
+	// letters such as the long s, which upper-cases to S, from passing.
+	for i := 0; i < len(code); i++ {
+		if code[i] >= utf8.RuneSelf {
+			return nil, fmt.Errorf("invalid card code: %q", code)
+		}
+	}
+	code = strings.ToUpper(code)
+	if len(code) == 3 && strings.HasPrefix(code, "10") {
+		code = string(Ten) + code[2:]
+	}
 	if len(code) != 2 {
 		return nil, fmt.Errorf("invalid card code: %s", code)
 	}
@@ -99,6 +115,16 @@ func (c *Card) RankValue() int {
 	return -1
 }
 
+// SuitIndex returns the position of a suit in AllSuits, or -1 if unknown.
+func SuitIndex(s Suit) int {
+	for i, suit := range AllSuits {
+		if suit == s {
+			return i
+		}
+	}
+	return -1
+}
+
 // Equal checks if two cards are identical.
 func (c *Card) Equal(other *Card) bool {
 	return c.Rank == other.Rank && c.Suit == other.Suit
@@ -115,24 +141,119 @@ func NewDeck() []*Card {
 	return deck
 }
 
-// RemoveCards returns a deck with specified cards removed.
+// rankBytes and suitBytes map rank and suit characters to their positions
+// in RankOrder and AllSuits plus one, so zero marks an unknown character.
+var rankBytes, suitBytes = func() (ranks, suits [256]uint8) {
+	for i, r := range RankOrder {
+		ranks[r[0]] = uint8(i + 1)
+	}
+	for i, s := range AllSuits {
+		suits[s[0]] = uint8(i + 1)
+	}
+	return ranks, suits
+}()
+
+// ID returns the card's integer ID in 0-51, rank*4 + suit, with ranks
+// numbered as RankValue (Two 0 to Ace 12) and suits in AllSuits order
+// (spades 0, hearts 1, diamonds 2, clubs 3). So 2S is 0, 2H is 1, 3S is 4
+// and AC is 51. The mapping is stable. ID returns -1 for a card with an
+// unknown rank or suit.
+func (c *Card) ID() int {
+	if len(c.Rank) != 1 || len(c.Suit) != 1 {
+		return -1
+	}
+	rank, suit := rankBytes[c.Rank[0]], suitBytes[c.Suit[0]]
+	if rank == 0 || suit == 0 {
+		return -1
+	}
+	return int(rank-1)*4 + int(suit-1)
+}
+
+// FromID returns the card with the given ID, the inverse of Card.ID.
+func FromID(id int) (*Card, error) {
+	if id < 0 || id >= 52 {
+		return nil, fmt.Errorf("invalid card id: %d", id)
+	}
+	return &Card{Rank: RankOrder[id/4], Suit: AllSuits[id%4]}, nil
+}
+
+// FromIDs converts card IDs to cards, as ParseCards does for codes.
+func FromIDs(ids []int) ([]*Card, error) {
+	cards := make([]*Card, 0, len(ids))
+	for _, id := range ids {
+		card, err := FromID(id)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// ParseIDList converts a list of card IDs separated by whitespace or
+// commas, such as "51 47, 43", to cards, as FromIDs does for integers.
+// Every entry must be an integer in 0-51.
+func ParseIDList(list string) ([]*Card, error) {
+	fields := strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	cards := make([]*Card, 0, len(fields))
+	for _, field := range fields {
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid card id: %q", field)
+		}
+		card, err := FromID(id)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// bit returns the card's bit in a 52-bit card set, indexed by ID, or 0
+// for a card with an unknown rank or suit.
+func (c *Card) bit() uint64 {
+	id := c.ID()
+	if id < 0 {
+		return 0
+	}
+	return 1 << uint(id)
+}
+
+// RemoveCards returns a deck with specified cards removed. The cards to
+// remove are collected into a Set so the deck is filtered in a single
+// pass.
 func RemoveCards(deck []*Card, toRemove []*Card) []*Card {
+	removed := NewSet(toRemove...)
+
 	result := make([]*Card, 0, len(deck))
 	for _, card := range deck {
-		keep := true
-		for _, remove := range toRemove {
-			if card.Equal(remove) {
-				keep = false
-				break
-			}
-		}
-		if keep {
+		if !removed.Contains(card) {
 			result = append(result, card)
 		}
 	}
 	return result
 }
 
+// ErrDuplicateCard is wrapped by errors reporting a repeated card.
+var ErrDuplicateCard = errors.New("duplicate card")
+
+// CheckUnique returns an error naming the first card that repeats an
+// earlier one.
+func CheckUnique(cards []*Card) error {
+	var seen Set
+	for _, c := range cards {
+		if seen.Contains(c) {
+			return fmt.Errorf("%w: %s", ErrDuplicateCard, c)
+		}
+		seen.Add(c)
+	}
+	return nil
+}
+
 // ParseCards converts string codes to Card objects.
 func ParseCards(codes []string) ([]*Card, error) {
 	cards := make([]*Card, 0, len(codes))
@@ -145,3 +266,14 @@ func ParseCards(codes []string) ([]*Card, error) {
 	}
 	return cards, nil
 }
+
+// MustParse parses a whitespace-separated list of card codes such as
+// "As Ks 7d" and panics if any code is invalid. It is intended for
+// fixtures and package-level tables where the input is a constant.
+func MustParse(s string) []*Card {
+	cards, err := ParseCards(strings.Fields(s))
+	if err != nil {
+		panic("card: MustParse(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return cards
+}
@@ -0,0 +1,100 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func FuzzNewCard(f *testing.F) {
+	for _, seed := range []string{"AS", "ah", "10h", "10", " Kd ", "Tc", "ſ", "aſ", "1", "", "XX", "AS AS"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, code string) {
+		c, err := NewCard(code)
+		if err != nil {
+			return
+		}
+		if c.RankValue() < 0 || SuitIndex(c.Suit) < 0 {
+			t.Fatalf("NewCard(%q) = %v, with an unknown rank or suit", code, c)
+		}
+		again, err := NewCard(c.String())
+		if err != nil {
+			t.Fatalf("NewCard(%q) = %v, which does not parse back: %v", code, c, err)
+		}
+		if !again.Equal(c) {
+			t.Fatalf("NewCard(%q) = %v, which parses back as %v", code, c, again)
+		}
+	})
+}
+
+// removeCardsNested is RemoveCards as it was before sets: every deck card
+// compared against every card to remove.
+func removeCardsNested(deck []*Card, toRemove []*Card) []*Card {
+	result := make([]*Card, 0, len(deck))
+	for _, c := range deck {
+		keep := true
+		for _, r := range toRemove {
+			if c.Equal(r) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func TestRemoveCardsMatchesNested(t *testing.T) {
+	deck := NewDeck()
+	unknown := &Card{Rank: "X", Suit: Spades}
+	for i := range deck {
+		for j := range deck {
+			// Copies of the deck's cards, so matching is by value.
+			toRemove := []*Card{{Rank: deck[i].Rank, Suit: deck[i].Suit}, {Rank: deck[j].Rank, Suit: deck[j].Suit}, unknown}
+			if got, want := RemoveCards(deck, toRemove), removeCardsNested(deck, toRemove); !reflect.DeepEqual(got, want) {
+				t.Fatalf("RemoveCards(deck, %v) = %v, want %v", toRemove, got, want)
+			}
+		}
+	}
+}
+
+func benchmarkRemoveCards(b *testing.B, remove func([]*Card, []*Card) []*Card, codes string) {
+	deck := NewDeck()
+	toRemove := MustParse(codes)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		remove(deck, toRemove)
+	}
+}
+
+const (
+	removeTwo    = "As Kd"
+	removeTwelve = "As Kd Qh Jc Ts 9d 8h 7c 6s 5d 4h 3c"
+)
+
+func BenchmarkRemoveCardsTwo(b *testing.B) {
+	benchmarkRemoveCards(b, RemoveCards, removeTwo)
+}
+
+func BenchmarkRemoveCardsTwelve(b *testing.B) {
+	benchmarkRemoveCards(b, RemoveCards, removeTwelve)
+}
+
+func BenchmarkRemoveCardsNestedTwo(b *testing.B) {
+	benchmarkRemoveCards(b, removeCardsNested, removeTwo)
+}
+
+func BenchmarkRemoveCardsNestedTwelve(b *testing.B) {
+	benchmarkRemoveCards(b, removeCardsNested, removeTwelve)
+}
+
+func BenchmarkNewDeckRemoveTwelve(b *testing.B) {
+	toRemove := MustParse(removeTwelve)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		RemoveCards(NewDeck(), toRemove)
+	}
+}
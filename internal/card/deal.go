@@ -0,0 +1,42 @@
+package card
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Shuffle shuffles a deck in place using the Fisher-Yates algorithm.
+// Pass a seeded rng for reproducible order; a nil rng uses a time-seeded source.
+func Shuffle(deck []*Card, rng *rand.Rand) {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	for i := len(deck) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+}
+
+// Deal draws n random cards from deck without modifying it.
+// It returns the dealt cards and the rest of the deck, or an error if the
+// deck holds fewer than n cards. A nil rng uses a time-seeded source.
+func Deal(deck []*Card, n int, rng *rand.Rand) (dealt, rest []*Card, err error) {
+	if n < 0 || n > len(deck) {
+		return nil, nil, fmt.Errorf("cannot deal %d cards from a deck of %d", n, len(deck))
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	shuffled := make([]*Card, len(deck))
+	copy(shuffled, deck)
+
+	// Partial Fisher-Yates: only the first n positions need to be random.
+	for i := 0; i < n; i++ {
+		j := i + rng.Intn(len(shuffled)-i)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+
+	return shuffled[:n:n], shuffled[n:], nil
+}
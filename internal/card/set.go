@@ -0,0 +1,66 @@
+package card
+
+import "math/bits"
+
+// Set is a set of cards backed by a 52-bit mask, with the bit for each
+// card indexed by its ID. The zero value is the empty set. Cards with an
+// unknown rank or suit have no bit and are ignored.
+type Set uint64
+
+// FullDeck is the set of all 52 cards.
+const FullDeck Set = 1<<52 - 1
+
+// NewSet returns the set of the given cards.
+func NewSet(cards ...*Card) Set {
+	var s Set
+	for _, c := range cards {
+		s.Add(c)
+	}
+	return s
+}
+
+// Add adds a card to the set.
+func (s *Set) Add(c *Card) {
+	*s |= Set(c.bit())
+}
+
+// Remove removes a card from the set.
+func (s *Set) Remove(c *Card) {
+	*s &^= Set(c.bit())
+}
+
+// Contains reports whether the card is in the set.
+func (s Set) Contains(c *Card) bool {
+	bit := Set(c.bit())
+	return bit != 0 && s&bit != 0
+}
+
+// Union returns the cards in either set.
+func (s Set) Union(other Set) Set {
+	return s | other
+}
+
+// Intersect returns the cards in both sets.
+func (s Set) Intersect(other Set) Set {
+	return s & other
+}
+
+// Without returns the cards in s that are not in other.
+func (s Set) Without(other Set) Set {
+	return s &^ other
+}
+
+// Len returns the number of cards in the set.
+func (s Set) Len() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Cards returns the cards in the set in ID order.
+func (s Set) Cards() []*Card {
+	cards := make([]*Card, 0, s.Len())
+	for m := uint64(s & FullDeck); m != 0; m &= m - 1 {
+		id := bits.TrailingZeros64(m)
+		cards = append(cards, &Card{Rank: RankOrder[id/4], Suit: AllSuits[id%4]})
+	}
+	return cards
+}
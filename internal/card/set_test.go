@@ -0,0 +1,75 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetEveryBit(t *testing.T) {
+	for id := 0; id < 52; id++ {
+		c, err := FromID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var s Set
+		s.Add(c)
+		if s != 1<<id || !s.Contains(c) || s.Len() != 1 {
+			t.Errorf("after Add(%v): set %b, Contains %v, Len %d", c, s, s.Contains(c), s.Len())
+		}
+		if cards := s.Cards(); len(cards) != 1 || !cards[0].Equal(c) {
+			t.Errorf("Set{%v}.Cards() = %v", c, cards)
+		}
+		s.Remove(c)
+		if s != 0 || s.Contains(c) {
+			t.Errorf("after Remove(%v): set %b", c, s)
+		}
+	}
+}
+
+func TestSetFullDeck(t *testing.T) {
+	deck := NewDeck()
+	s := NewSet(deck...)
+	if s != FullDeck || s.Len() != 52 {
+		t.Fatalf("NewSet(NewDeck()...) = %b with %d cards, want FullDeck", s, s.Len())
+	}
+
+	cards := s.Cards()
+	for i, c := range cards {
+		if c.ID() != i {
+			t.Fatalf("Cards()[%d] = %v with ID %d, want ID order", i, c, c.ID())
+		}
+	}
+	if NewSet(cards...) != FullDeck {
+		t.Error("FullDeck does not round-trip through Cards")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(MustParse("As Ks Qs")...)
+	b := NewSet(MustParse("Qs Jd")...)
+	tests := []struct {
+		name string
+		got  Set
+		want string
+	}{
+		{"union", a.Union(b), "As Ks Qs Jd"},
+		{"intersect", a.Intersect(b), "Qs"},
+		{"without", a.Without(b), "As Ks"},
+	}
+	for _, tt := range tests {
+		if want := NewSet(MustParse(tt.want)...); tt.got != want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got.Cards(), want.Cards())
+		}
+	}
+
+	unknown := &Card{Rank: "X", Suit: Spades}
+	s := a
+	s.Add(unknown)
+	if s != a || s.Contains(unknown) {
+		t.Errorf("a card with an unknown rank changed the set to %b", s)
+	}
+	if got := NewSet(MustParse("Ah Ah")...); got.Len() != 1 || !reflect.DeepEqual(got.Cards(), MustParse("Ah")) {
+		t.Errorf("NewSet(Ah, Ah) = %v", got.Cards())
+	}
+}
@@ -0,0 +1,116 @@
+package evaluator
+
+import "github.com/KyleKDang/poker-odds-engine/internal/card"
+
+// BadugiNames maps badugi hand sizes to their display names.
+var BadugiNames = map[int]string{
+	4: "Badugi",
+	3: "Three-Card Hand",
+	2: "Two-Card Hand",
+	1: "One-Card Hand",
+}
+
+// BadugiResult contains the evaluation result of a badugi hand.
+type BadugiResult struct {
+	// Cards is the best set of cards with distinct suits and ranks.
+	Cards []*card.Card
+	Label string
+	// Ranks holds ace-low rank values (A=0 ... K=12), highest first.
+	Ranks []int
+}
+
+// EvaluateBadugi finds the best badugi hand from the given cards: the
+// largest set of cards with all distinct suits and ranks, and among sets of
+// that size the one with the lowest cards. Aces are low.
+func EvaluateBadugi(cards []*card.Card) *BadugiResult {
+	if len(cards) < 1 {
+		return nil
+	}
+
+	maxSize := 4
+	if len(cards) < maxSize {
+		maxSize = len(cards)
+	}
+
+	for size := maxSize; size >= 1; size-- {
+		var best *BadugiResult
+		for _, combo := range generateCombinations(cards, size) {
+			if !isBadugiSet(combo) {
+				continue
+			}
+			result := newBadugiResult(combo)
+			if best == nil || result.Compare(best) > 0 {
+				best = result
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+	return nil
+}
+
+// Compare compares two badugi results.
+// Returns: 1 if b1 wins, -1 if b2 wins, 0 if tie.
+func (b1 *BadugiResult) Compare(b2 *BadugiResult) int {
+	if len(b1.Ranks) != len(b2.Ranks) {
+		if len(b1.Ranks) > len(b2.Ranks) {
+			return 1
+		}
+		return -1
+	}
+
+	// Same size - the lower highest card wins, then the next, and so on
+	for i := range b1.Ranks {
+		if b1.Ranks[i] < b2.Ranks[i] {
+			return 1
+		}
+		if b1.Ranks[i] > b2.Ranks[i] {
+			return -1
+		}
+	}
+	return 0
+}
+
+// isBadugiSet checks that no two cards share a suit or rank.
+func isBadugiSet(cards []*card.Card) bool {
+	for i, a := range cards {
+		for _, b := range cards[i+1:] {
+			if a.Suit == b.Suit || a.Rank == b.Rank {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// newBadugiResult builds a result from a valid badugi set.
+func newBadugiResult(cards []*card.Card) *BadugiResult {
+	ranks := make([]int, len(cards))
+	for i, c := range cards {
+		ranks[i] = aceLowValue(c)
+	}
+
+	// Sort ranks (descending)
+	for i := 0; i < len(ranks); i++ {
+		for j := i + 1; j < len(ranks); j++ {
+			if ranks[j] > ranks[i] {
+				ranks[i], ranks[j] = ranks[j], ranks[i]
+			}
+		}
+	}
+
+	return &BadugiResult{
+		Cards: cards,
+		Label: BadugiNames[len(cards)],
+		Ranks: ranks,
+	}
+}
+
+// aceLowValue returns a rank value with the ace counted below the two.
+func aceLowValue(c *card.Card) int {
+	if c.Rank == card.Ace {
+		return 0
+	}
+	return c.RankValue() + 1
+}
@@ -0,0 +1,32 @@
+package evaluator
+
+import "github.com/KyleKDang/poker-odds-engine/internal/card"
+
+// Blockers counts the opponent combos that would make a hand of category
+// on the board but are impossible because they contain one of the hero's
+// hole cards. Holding the ace of the board's flush suit, for example,
+// blocks every nut flush combo.
+func Blockers(hole, board []*card.Card, category HandRank) int {
+	deck := card.RemoveCards(card.NewDeck(), board)
+
+	held := make(map[card.Card]bool, len(hole))
+	for _, c := range hole {
+		held[*c] = true
+	}
+
+	blocked := 0
+	opponent := make([]*card.Card, 2+len(board))
+	copy(opponent[2:], board)
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			if !held[*deck[i]] && !held[*deck[j]] {
+				continue
+			}
+			opponent[0], opponent[1] = deck[i], deck[j]
+			if EvaluateHand(opponent).Rank == category {
+				blocked++
+			}
+		}
+	}
+	return blocked
+}
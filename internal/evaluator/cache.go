@@ -0,0 +1,83 @@
+package evaluator
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+var (
+	cacheEnabled atomic.Bool
+	// fiveCardCache maps a 5-card hand's cacheKey to its evaluation. It
+	// holds at most 7462 entries, one per distinct rank pattern and flush,
+	// and is read far more often than written, as sync.Map suits.
+	fiveCardCache sync.Map
+	cacheHits     atomic.Int64
+	cacheMisses   atomic.Int64
+)
+
+// cachedHand is the part of a 5-card evaluation shared by every hand with
+// the same key.
+type cachedHand struct {
+	rank    HandRank
+	kickers []int
+}
+
+// SetCache turns the 5-card evaluation cache on or off. Hands that differ
+// only in suits, other than being suited, evaluate the same, so across a
+// multiway simulation the same few thousand keys cover millions of
+// evaluations. It is off by default, and safe to change while evaluating;
+// entries are kept when it is turned off.
+func SetCache(enabled bool) {
+	cacheEnabled.Store(enabled)
+}
+
+// CacheStats reports the lookups the evaluation cache has answered and
+// missed since the process started.
+func CacheStats() (hits, misses int64) {
+	return cacheHits.Load(), cacheMisses.Load()
+}
+
+// cacheKey returns the suit-isomorphic signature of five cards: the count
+// of each rank in three bits, plus whether they are a flush. It reports
+// false for cards with an unknown rank, which are not cached.
+func cacheKey(cards []*card.Card) (uint64, bool) {
+	var key uint64
+	for _, c := range cards {
+		value := c.RankValue()
+		if value < 0 {
+			return 0, false
+		}
+		key += 1 << (3 * value)
+	}
+	if isFlush(cards) {
+		key |= 1 << 39
+	}
+	return key, true
+}
+
+// evaluateCached evaluates exactly five cards through the cache, returning
+// a fresh result the caller may modify.
+func evaluateCached(cards []*card.Card) *HandResult {
+	key, ok := cacheKey(cards)
+	if !ok {
+		return evaluateUncached(cards)
+	}
+
+	entry, ok := fiveCardCache.Load(key)
+	if ok {
+		cacheHits.Add(1)
+	} else {
+		cacheMisses.Add(1)
+		result := evaluateUncached(cards)
+		entry, _ = fiveCardCache.LoadOrStore(key, cachedHand{rank: result.Rank, kickers: result.Kickers})
+	}
+
+	hand := entry.(cachedHand)
+	return &HandResult{
+		Rank:    hand.rank,
+		Label:   HandRankNames[hand.rank],
+		Kickers: append(make([]int, 0, len(hand.kickers)), hand.kickers...),
+	}
+}
@@ -0,0 +1,79 @@
+package evaluator
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// withCache runs f with the evaluation cache set to enabled, restoring it
+// to off afterwards.
+func withCache(enabled bool, f func()) {
+	SetCache(enabled)
+	defer SetCache(false)
+	f()
+}
+
+func TestCacheMatchesUncached(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		deck := card.NewDeck()
+		card.Shuffle(deck, rng)
+		hand := deck[:7]
+
+		var cached, uncached *HandResult
+		withCache(true, func() { cached = EvaluateHand(hand) })
+		uncached = EvaluateHand(hand)
+		if cached.Rank != uncached.Rank || cached.Label != uncached.Label || !reflect.DeepEqual(cached.Kickers, uncached.Kickers) {
+			t.Fatalf("%v: cached %s %v, uncached %s %v", hand, cached.Label, cached.Kickers, uncached.Label, uncached.Kickers)
+		}
+	}
+}
+
+// TestCacheResultsAreFresh checks that changing a cached result's kickers
+// does not change the next lookup of the same key.
+func TestCacheResultsAreFresh(t *testing.T) {
+	withCache(true, func() {
+		first := EvaluateHand(mustCards(t, "As Ks Qd 7c 2h"))
+		first.Kickers[0] = -5
+		second := EvaluateHand(mustCards(t, "Ad Kc Qh 7s 2d"))
+		if second.Kickers[0] != 12 {
+			t.Errorf("cached kickers %v were changed through an earlier result", second.Kickers)
+		}
+	})
+}
+
+// BenchmarkEvaluateSevenCards evaluates random 7-card hands with the cache
+// off and on, reporting the cache's hit rate.
+func BenchmarkEvaluateSevenCards(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	hands := make([][]*card.Card, 1000)
+	for i := range hands {
+		deck := card.NewDeck()
+		card.Shuffle(deck, rng)
+		hands[i] = deck[:7]
+	}
+
+	for _, enabled := range []bool{false, true} {
+		name := "uncached"
+		if enabled {
+			name = "cached"
+		}
+		b.Run(name, func(b *testing.B) {
+			withCache(enabled, func() {
+				hits, misses := CacheStats()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					EvaluateHand(hands[i%len(hands)])
+				}
+				if enabled {
+					newHits, newMisses := CacheStats()
+					hits, misses = newHits-hits, newMisses-misses
+					b.ReportMetric(100*float64(hits)/float64(hits+misses), "%hits")
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package evaluator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// ErrInvalidHand is wrapped by errors reporting cards that cannot form a
+// hand: too few or too many, or a card with an unknown rank or suit.
+// Repeated cards are reported with card.ErrDuplicateCard instead.
+var ErrInvalidHand = errors.New("invalid hand")
+
+// CheckHand verifies that cards can be dealt together: 1-7 real cards with
+// none repeated. EvaluateHand trusts its input, and seven copies of the
+// ace of spades would otherwise evaluate as an impossible hand.
+func CheckHand(cards []*card.Card) error {
+	if len(cards) < 1 || len(cards) > 7 {
+		return fmt.Errorf("%w: must have 1-7 cards, got %d", ErrInvalidHand, len(cards))
+	}
+	for i, c := range cards {
+		if c == nil || c.ID() < 0 {
+			return fmt.Errorf("%w: card %d is not a valid card", ErrInvalidHand, i+1)
+		}
+	}
+	return card.CheckUnique(cards)
+}
+
+// Evaluate is EvaluateHand for untrusted input. It returns the error from
+// CheckHand rather than evaluating cards that cannot form a hand.
+func Evaluate(cards []*card.Card) (*HandResult, error) {
+	if err := CheckHand(cards); err != nil {
+		return nil, err
+	}
+	return EvaluateHand(cards), nil
+}
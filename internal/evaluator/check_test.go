@@ -0,0 +1,73 @@
+package evaluator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+func TestCheckHand(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []*card.Card
+		want  error
+	}{
+		{"one card", mustCards(t, "As"), nil},
+		{"seven cards", mustCards(t, "As Ks Qs Js Ts 9s 8s"), nil},
+		{"no cards", nil, ErrInvalidHand},
+		{"eight cards", mustCards(t, "As Ks Qs Js Ts 9s 8s 7s"), ErrInvalidHand},
+		{"seven of the same card", mustCards(t, "As As As As As As As"), card.ErrDuplicateCard},
+		{"board repeats a hole card", mustCards(t, "As Ks As 7d 2c"), card.ErrDuplicateCard},
+		{"unknown suit", append(mustCards(t, "Ah Kh 9h 7h"), &card.Card{Rank: card.Two, Suit: "X"}), ErrInvalidHand},
+		{"missing card", []*card.Card{nil, mustCards(t, "As")[0]}, ErrInvalidHand},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.cards)
+			if !errors.Is(err, tt.want) || (tt.want == nil) != (err == nil) {
+				t.Fatalf("Evaluate error = %v, want %v", err, tt.want)
+			}
+			if (result == nil) == (err == nil) {
+				t.Errorf("Evaluate = %v with error %v", result, err)
+			}
+		})
+	}
+}
+
+// FuzzEvaluate feeds Evaluate card lists of any length, with repeats and
+// unknown cards, and checks that it fails exactly for impossible hands.
+func FuzzEvaluate(f *testing.F) {
+	f.Add([]byte{12, 11, 10, 9, 8})
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0})
+	f.Add([]byte{12, 25, 38, 51, 0, 1, 2, 3})
+	f.Add([]byte{12, 11, 10, 9, 200})
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		valid := len(data) >= 1 && len(data) <= 7
+		seen := make(map[byte]bool)
+		for _, b := range data {
+			valid = valid && b < 52 && !seen[b]
+			seen[b] = true
+		}
+
+		cards := fuzzCards(data)
+		result, err := Evaluate(cards)
+		if valid {
+			if err != nil {
+				t.Fatalf("Evaluate(%v): %v", cards, err)
+			}
+			if result.Compare(EvaluateHand(cards)) != 0 {
+				t.Fatalf("Evaluate(%v) = %s, EvaluateHand = %s", cards, result.Label, EvaluateHand(cards).Label)
+			}
+			return
+		}
+		if err == nil {
+			t.Fatalf("Evaluate(%v) = %s, want an error", cards, result.Label)
+		}
+		if !errors.Is(err, ErrInvalidHand) && !errors.Is(err, card.ErrDuplicateCard) {
+			t.Fatalf("Evaluate(%v): unexpected error %v", cards, err)
+		}
+	})
+}
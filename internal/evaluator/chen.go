@@ -0,0 +1,117 @@
+package evaluator
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// Preflop strength buckets returned by PreflopBucket.
+const (
+	BucketStrong = "strong"
+	BucketMedium = "medium"
+	BucketWeak   = "weak"
+)
+
+// StartingHand names a two-card starting hand by its canonical class, such
+// as "AA", "AKs", or "T9o", higher card first.
+func StartingHand(hole []*card.Card) (string, error) {
+	if len(hole) != 2 {
+		return "", fmt.Errorf("starting hand needs 2 cards, got %d", len(hole))
+	}
+
+	high, low := hole[0], hole[1]
+	if low.RankValue() > high.RankValue() {
+		high, low = low, high
+	}
+
+	name := string(high.Rank) + string(low.Rank)
+	switch {
+	case high.Rank == low.Rank:
+		return name, nil
+	case high.Suit == low.Suit:
+		return name + "s", nil
+	default:
+		return name + "o", nil
+	}
+}
+
+// ChenScore rates a two-card starting hand with Bill Chen's formula, from
+// -1 (72o) to 20 (AA), without any simulation:
+//   - the high card scores 10 for an ace, 8 for a king, 7 for a queen,
+//     6 for a jack, and half its face value below that
+//   - a pair doubles that score, to at least 5
+//   - suited cards add 2
+//   - gaps between the ranks subtract 1, 2, 4, or 5 for four or more
+//   - unpaired cards below a queen with at most one gap add 1
+//
+// The result is rounded up to a whole number.
+func ChenScore(hole []*card.Card) (int, error) {
+	if len(hole) != 2 {
+		return 0, fmt.Errorf("chen score needs 2 cards, got %d", len(hole))
+	}
+
+	high, low := hole[0].RankValue(), hole[1].RankValue()
+	if low > high {
+		high, low = low, high
+	}
+
+	score := chenCardScore(high)
+	if high == low {
+		return int(math.Ceil(math.Max(score*2, 5))), nil
+	}
+
+	if hole[0].Suit == hole[1].Suit {
+		score += 2
+	}
+
+	gap := high - low - 1
+	switch {
+	case gap == 1:
+		score--
+	case gap == 2:
+		score -= 2
+	case gap == 3:
+		score -= 4
+	case gap >= 4:
+		score -= 5
+	}
+
+	queen := len(card.RankOrder) - 3
+	if gap <= 1 && high < queen {
+		score++
+	}
+
+	return int(math.Ceil(score)), nil
+}
+
+// chenCardScore returns the Chen formula score of a card by rank value.
+func chenCardScore(value int) float64 {
+	switch card.RankOrder[value] {
+	case card.Ace:
+		return 10
+	case card.King:
+		return 8
+	case card.Queen:
+		return 7
+	case card.Jack:
+		return 6
+	default:
+		// Rank values start at 0 for a deuce, worth 1.
+		return float64(value+2) / 2
+	}
+}
+
+// PreflopBucket groups a Chen score into a coarse strength bucket: strong
+// from 10 (TT, AKo, AQs and better), medium from 7, and weak below.
+func PreflopBucket(chen int) string {
+	switch {
+	case chen >= 10:
+		return BucketStrong
+	case chen >= 7:
+		return BucketMedium
+	default:
+		return BucketWeak
+	}
+}
@@ -0,0 +1,102 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// NumHandClasses is the number of distinct 5-card hand strengths.
+const NumHandClasses = 7462
+
+var (
+	classOnce sync.Once
+	// classes maps a hand's Key to its class, built on first use.
+	classes map[uint64]int
+)
+
+// HandClass returns the strength of the best 5-card hand among 5-7 cards
+// as a single integer in [1, NumHandClasses], in the style of Cactus Kev's
+// evaluator: 1 is a royal flush, 7462 is seven-high, and a lower class
+// beats a higher one. Hands with the same class tie.
+func HandClass(cards []*card.Card) (int, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, fmt.Errorf("hand class needs 5-7 cards, got %d", len(cards))
+	}
+	if err := CheckHand(cards); err != nil {
+		return 0, err
+	}
+
+	return EvaluateHand(cards).Class(), nil
+}
+
+// Class returns the hand's class as described for HandClass. It is 0 for
+// partial hands of fewer than five cards, which have no class.
+func (h *HandResult) Class() int {
+	classOnce.Do(buildClasses)
+	return classes[h.Key()]
+}
+
+// WarmUp builds the lookup tables that are otherwise built on first use,
+// so the first request that needs them does not pay for it. It is safe to
+// call more than once and concurrently with evaluation.
+func WarmUp() {
+	classOnce.Do(buildClasses)
+}
+
+// buildClasses evaluates one hand for every 5-card rank pattern, plus a
+// flush for every set of five distinct ranks, and numbers the distinct
+// keys from strongest to weakest.
+func buildClasses() {
+	seen := make(map[uint64]bool, NumHandClasses)
+	hand := make([]*card.Card, 5)
+
+	var choose func(start, n int, counts *[13]int)
+	choose = func(start, n int, counts *[13]int) {
+		if n == 5 {
+			// Deal repeated ranks across suits; distinct ranks rotate suits
+			// so the hand is not a flush.
+			i := 0
+			for rank, count := range counts {
+				for k := 0; k < count; k++ {
+					hand[i] = &card.Card{Rank: card.RankOrder[rank], Suit: card.AllSuits[(i+k)%4]}
+					i++
+				}
+			}
+			seen[evaluateFiveCardHand(hand).Key()] = true
+
+			distinct := true
+			for _, count := range counts {
+				distinct = distinct && count < 2
+			}
+			if distinct {
+				for i := range hand {
+					hand[i] = &card.Card{Rank: hand[i].Rank, Suit: card.Spades}
+				}
+				seen[evaluateFiveCardHand(hand).Key()] = true
+			}
+			return
+		}
+		for rank := start; rank < 13; rank++ {
+			if counts[rank] < 4 {
+				counts[rank]++
+				choose(rank, n+1, counts)
+				counts[rank]--
+			}
+		}
+	}
+	choose(0, 0, &[13]int{})
+
+	keys := make([]uint64, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	classes = make(map[uint64]int, len(keys))
+	for i, key := range keys {
+		classes[key] = i + 1
+	}
+}
@@ -0,0 +1,69 @@
+package evaluator
+
+import "github.com/KyleKDang/poker-odds-engine/internal/card"
+
+// Situation labels returned by Classify.
+const (
+	MadeHand   = "made hand"
+	StrongDraw = "strong draw"
+	WeakDraw   = "weak draw"
+	Air        = "air"
+)
+
+// Classify summarizes the hero's situation in one label, checked in order:
+//   - MadeHand: the hole cards improve the hand category beyond what the
+//     board makes alone, so a pair on the board does not count. Preflop,
+//     only a pocket pair is a made hand.
+//   - StrongDraw: a flush draw or an open-ended straight draw, as reported
+//     by DetectDraws, with cards still to come. Overcards may add to it.
+//   - WeakDraw: a gutshot straight draw, or two overcards to the board.
+//   - Air: none of the above.
+//
+// Draws only count before the river.
+func Classify(hole, board []*card.Card) string {
+	all := make([]*card.Card, 0, len(hole)+len(board))
+	all = append(all, hole...)
+	all = append(all, board...)
+
+	var boardRank HandRank
+	if len(board) > 0 {
+		boardRank = EvaluateHand(board).Rank
+	}
+	if result := EvaluateHand(all); result != nil && result.Rank >= OnePair && result.Rank > boardRank {
+		return MadeHand
+	}
+
+	if len(board) == 0 || len(board) >= 5 {
+		return Air
+	}
+
+	gutshot := false
+	for _, draw := range DetectDraws(hole, board) {
+		switch draw {
+		case FlushDraw, OpenEndedStraightDraw:
+			return StrongDraw
+		case GutshotStraightDraw:
+			gutshot = true
+		}
+	}
+	if gutshot || overcards(hole, board) == len(hole) {
+		return WeakDraw
+	}
+	return Air
+}
+
+// overcards counts the hole cards ranked above every board card.
+func overcards(hole, board []*card.Card) int {
+	top := -1
+	for _, c := range board {
+		top = max(top, c.RankValue())
+	}
+
+	count := 0
+	for _, c := range hole {
+		if c.RankValue() > top {
+			count++
+		}
+	}
+	return count
+}
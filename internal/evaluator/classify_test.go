@@ -0,0 +1,35 @@
+package evaluator
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  string
+		board string
+		want  string
+	}{
+		{"pocket pair preflop", "7c 7d", "", MadeHand},
+		{"unpaired preflop", "Ah Kd", "", Air},
+		{"top pair", "Ah Kd", "Ac 7h 2s", MadeHand},
+		{"pair on the board with overcards", "Ah Kd", "7c 7h 2s", WeakDraw},
+		{"flush draw", "Ah 4h", "Kh 9h 2c", StrongDraw},
+		{"open-ended", "9c 8d", "7h 6s 2c", StrongDraw},
+		{"gutshot", "9c 8d", "6h 5s Kc", WeakDraw},
+		{"two overcards", "Ac Kd", "9h 6s 2c", WeakDraw},
+		{"one overcard", "Ac 3d", "9h 6s 2c Jd", Air},
+		{"open-ended board with overcards", "Ac Kd", "5h 6c 7d 8s", WeakDraw},
+		{"four-flush board with overcards", "Ac Kd", "2h 6h 9h Th", WeakDraw},
+		{"four-flush board", "4c 3d", "Kh 8h 9h Th", Air},
+		{"flush draw missed on the river", "Ah 4h", "Kh 9h 2c 7d Js", Air},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Classify(mustCards(t, tt.hole), mustCards(t, tt.board))
+			if got != tt.want {
+				t.Errorf("Classify(%s | %s) = %q, want %q", tt.hole, tt.board, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package evaluator
+
+// CompareMode selects how much of two hands a comparison looks at.
+type CompareMode int
+
+const (
+	// CompareKickers compares the category and then the kickers, as
+	// Compare does.
+	CompareKickers CompareMode = iota
+	// CompareCategory compares the category alone, so any two hands of the
+	// same category tie, such as two pair of kings and fives against two
+	// pair of queens and jacks.
+	CompareCategory
+)
+
+// CompareBy compares two hands under mode, returning 1 if h1 wins, -1 if
+// h2 wins, or 0 for a tie.
+func (h1 *HandResult) CompareBy(h2 *HandResult, mode CompareMode) int {
+	if mode != CompareCategory {
+		return h1.Compare(h2)
+	}
+	switch {
+	case h1.Rank > h2.Rank:
+		return 1
+	case h1.Rank < h2.Rank:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,43 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// EvaluateConstrained finds the best 5-card hand using exactly holeUse hole
+// cards and boardUse board cards, as in Omaha (2 and 3).
+//
+// It does not replace EvaluateHand for hold'em, where any split of hole and
+// board cards plays. The best hold'em hand is the best EvaluateConstrained
+// result over every split, but EvaluateHand finds it in one pass over the
+// combined cards and also takes fewer than five, so hold'em keeps using it.
+func EvaluateConstrained(hole, board []*card.Card, holeUse, boardUse int) (*HandResult, error) {
+	if holeUse < 0 || boardUse < 0 || holeUse+boardUse != 5 {
+		return nil, fmt.Errorf("must use 5 cards in total, got %d hole and %d board", holeUse, boardUse)
+	}
+	if len(hole) < holeUse {
+		return nil, fmt.Errorf("must use %d hole cards but only %d given", holeUse, len(hole))
+	}
+	if len(board) < boardUse {
+		return nil, fmt.Errorf("must use %d board cards but only %d given", boardUse, len(board))
+	}
+
+	var bestHand *HandResult
+	boardCombos := generateCombinations(board, boardUse)
+	for _, holeCombo := range generateCombinations(hole, holeUse) {
+		for _, boardCombo := range boardCombos {
+			combo := make([]*card.Card, 0, 5)
+			combo = append(combo, holeCombo...)
+			combo = append(combo, boardCombo...)
+
+			result := evaluateFiveCardHand(combo)
+			if bestHand == nil || result.Compare(bestHand) > 0 {
+				result.Cards = combo
+				bestHand = result
+			}
+		}
+	}
+	return bestHand, nil
+}
@@ -0,0 +1,77 @@
+package evaluator
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// TestConstrainedCoversHoldem checks that the best EvaluateConstrained
+// result over every split of hole and board cards is the hold'em hand
+// EvaluateHand finds.
+func TestConstrainedCoversHoldem(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		deck := card.NewDeck()
+		card.Shuffle(deck, rng)
+		hole, board := deck[:2], deck[2:5+rng.Intn(3)]
+
+		var best *HandResult
+		for holeUse := 0; holeUse <= len(hole); holeUse++ {
+			boardUse := 5 - holeUse
+			if boardUse > len(board) {
+				continue
+			}
+			result, err := EvaluateConstrained(hole, board, holeUse, boardUse)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if best == nil || result.Compare(best) > 0 {
+				best = result
+			}
+		}
+
+		all := append(append([]*card.Card{}, hole...), board...)
+		if want := EvaluateHand(all); best.Compare(want) != 0 {
+			t.Fatalf("%v on %v: best split %s %v, EvaluateHand %s %v", hole, board, best.Label, best.Kickers, want.Label, want.Kickers)
+		}
+	}
+}
+
+func TestEvaluateConstrained(t *testing.T) {
+	tests := []struct {
+		name              string
+		hole, board       string
+		holeUse, boardUse int
+		want              string
+		wantErr           bool
+	}{
+		{"omaha flush from two suited hole cards", "Ah Kh Qc Js", "2h 5h 9h Th 3c", 2, 3, "Flush", false},
+		{"omaha cannot play four of a suit on board", "As Kd Qc Jc", "2h 5h 9h Th 3c", 2, 3, "High Card", false},
+		{"big o", "Ah Kh 2c 3d 4s", "5h 9h Tc Jd Qs", 2, 3, "Straight", false},
+		{"board plays", "2c 3d", "Ah Kh Qh Jh Th", 0, 5, "Royal Flush", false},
+		{"split must make five", "Ah Kd Qc Js", "2h 5h 9h", 2, 2, "", true},
+		{"negative split", "Ah Kd Qc Js", "2h 5h 9h", 6, -1, "", true},
+		{"too few hole cards", "Ah", "2h 5h 9h Th", 2, 3, "", true},
+		{"too few board cards", "Ah Kd Qc Js", "2h 5h", 2, 3, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := EvaluateConstrained(mustCards(t, tt.hole), mustCards(t, tt.board), tt.holeUse, tt.boardUse)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("EvaluateConstrained = %s, want an error", result.Label)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Label != tt.want {
+				t.Errorf("EvaluateConstrained = %s, want %s", result.Label, tt.want)
+			}
+		})
+	}
+}
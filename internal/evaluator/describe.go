@@ -0,0 +1,61 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// Describe names the hand in English with its ranking cards, such as
+// "Pair of Kings with Ace kicker" or "Kings full of Twos". Only the
+// kicker that matters most is named. Partial hands leave out kickers
+// they do not have.
+func (h *HandResult) Describe() string {
+	k := func(i int) int {
+		if i < len(h.Kickers) {
+			return h.Kickers[i]
+		}
+		return missingKicker
+	}
+	// withKicker appends the first side card, at index i, when there is one.
+	withKicker := func(s string, i int) string {
+		if k(i) == missingKicker {
+			return s
+		}
+		return fmt.Sprintf("%s with %s kicker", s, kickerName(k(i)))
+	}
+
+	switch h.Rank {
+	case RoyalFlush:
+		return "Royal flush"
+	case StraightFlush:
+		return fmt.Sprintf("%s-high straight flush", kickerName(k(0)))
+	case FourOfAKind:
+		return withKicker("Four "+pluralRankName(k(0)), 1)
+	case FullHouse:
+		return fmt.Sprintf("%s full of %s", pluralRankName(k(0)), pluralRankName(k(1)))
+	case Flush:
+		return fmt.Sprintf("%s-high flush", kickerName(k(0)))
+	case Straight:
+		return fmt.Sprintf("%s-high straight", kickerName(k(0)))
+	case ThreeOfAKind:
+		return withKicker("Three "+pluralRankName(k(0)), 1)
+	case TwoPair:
+		return withKicker(fmt.Sprintf("%s and %s", pluralRankName(k(0)), pluralRankName(k(1))), 2)
+	case OnePair:
+		return withKicker("Pair of "+pluralRankName(k(0)), 1)
+	case HighCard:
+		return withKicker(kickerName(k(0))+" high", 1)
+	}
+	return h.Label
+}
+
+// pluralRankName returns the plural display name of a rank value, such as
+// "Kings" or "Sixes".
+func pluralRankName(value int) string {
+	name := kickerName(value)
+	if name == RankNames[card.Six] {
+		return name + "es"
+	}
+	return name + "s"
+}
@@ -0,0 +1,123 @@
+package evaluator
+
+import "github.com/KyleKDang/poker-odds-engine/internal/card"
+
+// Draw names reported by DetectDraws.
+const (
+	FlushDraw             = "Flush Draw"
+	OpenEndedStraightDraw = "Open-Ended Straight Draw"
+	GutshotStraightDraw   = "Gutshot Straight Draw"
+	// Backdoor draws need both the turn and the river, so they are only
+	// reported on the flop.
+	BackdoorFlushDraw    = "Backdoor Flush Draw"
+	BackdoorStraightDraw = "Backdoor Straight Draw"
+)
+
+// DetectDraws reports the flush and straight draws formed by the hole and
+// board cards. A draw is only reported when that hand is not already made
+// and a hole card plays in it: a flush draw needs a hole card of the suit,
+// and a rank only completes a straight draw when the straight it makes
+// beats the one it would make with the board alone.
+// A straight draw with two or more completing ranks (including double
+// gutshots) is open-ended; one completing rank is a gutshot.
+//
+// With a three-card board, runner-runner draws are reported too: three to
+// a flush is a backdoor flush draw, and a straight that two more ranks
+// would complete is a backdoor straight draw when no single rank does.
+func DetectDraws(hole, board []*card.Card) []string {
+	cards := make([]*card.Card, 0, len(hole)+len(board))
+	cards = append(cards, hole...)
+	cards = append(cards, board...)
+
+	draws := []string{}
+
+	suitCounts := make(map[card.Suit]int)
+	for _, c := range cards {
+		suitCounts[c.Suit]++
+	}
+	heldSuited := 0
+	for suit, count := range suitCounts {
+		if count > heldSuited && holdsSuit(hole, suit) {
+			heldSuited = count
+		}
+	}
+	backdoor := len(board) == 3
+	if heldSuited == 4 {
+		draws = append(draws, FlushDraw)
+	} else if heldSuited == 3 && backdoor {
+		draws = append(draws, BackdoorFlushDraw)
+	}
+
+	mask, boardMask := rankMask(cards), rankMask(board)
+	if straightHigh(mask) < 0 {
+		outs := 0
+		for v := 0; v < len(card.RankOrder); v++ {
+			bit := uint16(1) << v
+			if mask&bit == 0 && straightHigh(mask|bit) > straightHigh(boardMask|bit) {
+				outs++
+			}
+		}
+		if outs >= 2 {
+			draws = append(draws, OpenEndedStraightDraw)
+		} else if outs == 1 {
+			draws = append(draws, GutshotStraightDraw)
+		} else if backdoor && runnerRunnerStraight(mask, boardMask) {
+			draws = append(draws, BackdoorStraightDraw)
+		}
+	}
+
+	return draws
+}
+
+// holdsSuit reports whether any hole card is of suit.
+func holdsSuit(hole []*card.Card, suit card.Suit) bool {
+	for _, c := range hole {
+		if c.Suit == suit {
+			return true
+		}
+	}
+	return false
+}
+
+// runnerRunnerStraight reports whether adding two missing ranks to mask
+// completes a straight better than those ranks make with boardMask alone.
+func runnerRunnerStraight(mask, boardMask uint16) bool {
+	for a := 0; a < len(card.RankOrder); a++ {
+		for b := a + 1; b < len(card.RankOrder); b++ {
+			pair := uint16(1)<<a | uint16(1)<<b
+			if mask&pair == 0 && straightHigh(mask|pair) > straightHigh(boardMask|pair) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rankMask returns a 13-bit mask with bit i set when rank value i is
+// present. Unknown ranks set no bit.
+func rankMask(cards []*card.Card) uint16 {
+	var mask uint16
+	for _, c := range cards {
+		if v := c.RankValue(); v >= 0 {
+			mask |= 1 << v
+		}
+	}
+	return mask
+}
+
+// straightHigh returns the high rank value of the best straight in a rank
+// mask, or -1 if there is none. The wheel (A-2-3-4-5) is five-high.
+func straightHigh(mask uint16) int {
+	for high := len(card.RankOrder) - 1; high >= 4; high-- {
+		window := uint16(0x1F) << (high - 4)
+		if mask&window == window {
+			return high
+		}
+	}
+
+	const wheel = 1<<12 | 0xF
+	if mask&wheel == wheel {
+		return 3
+	}
+	return -1
+}
@@ -0,0 +1,40 @@
+package evaluator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDetectDraws(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  string
+		board string
+		want  []string
+	}{
+		{"nut flush draw", "Ah 4h", "Kh 9c 2h 7d", []string{FlushDraw}},
+		{"open-ended", "9c 8d", "7h 6s 2c Kd", []string{OpenEndedStraightDraw}},
+		{"gutshot", "9c 8d", "6h 5s 2c Kd", []string{GutshotStraightDraw}},
+		{"one hole card of a four-flush board", "Ah Kd", "2h 5h 9h Tc", []string{FlushDraw}},
+		{"four-flush on the board", "Ac Kd", "2h 5h 9h Th", []string{}},
+		{"open-ended board", "Ac Kd", "5h 6c 7d 8s", []string{}},
+		{"hole card only tops the board's straight", "Tc 2d", "5h 6c 7d 8s", []string{GutshotStraightDraw}},
+		{"made straight", "9c 4d", "5h 6c 7d 8s", []string{}},
+		{"backdoor flush", "Ah 4h", "Kh 9c 7d", []string{BackdoorFlushDraw}},
+		{"backdoor flush with one hole card", "Ah 8d", "Jh 6h 3c", []string{BackdoorFlushDraw}},
+		{"three-flush on the board", "As Kd", "2h 7h 9h", []string{}},
+		{"backdoor straight", "Jc Td", "8h 3s 2c", []string{BackdoorStraightDraw}},
+		{"run on the board", "Ac Kd", "5h 6c 7d", []string{}},
+		{"run on the board with a connected hole card", "9c 2d", "5h 6c 7d", []string{GutshotStraightDraw}},
+		{"backdoor flush and straight", "Jh Td", "8h 3h 2c", []string{BackdoorFlushDraw, BackdoorStraightDraw}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectDraws(mustCards(t, tt.hole), mustCards(t, tt.board))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DetectDraws(%s | %s) = %v, want %v", tt.hole, tt.board, got, tt.want)
+			}
+		})
+	}
+}
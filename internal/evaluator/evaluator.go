@@ -9,7 +9,9 @@ func EvaluateHand(cards []*card.Card) *HandResult {
 	}
 
 	if len(cards) < 5 {
-		return evaluateFiveCardHand(cards)
+		result := evaluateFiveCardHand(cards)
+		result.Cards = cards
+		return result
 	}
 
 	var bestHand *HandResult
@@ -18,6 +20,7 @@ func EvaluateHand(cards []*card.Card) *HandResult {
 	for _, combo := range combinations {
 		result := evaluateFiveCardHand(combo)
 		if bestHand == nil || result.Compare(bestHand) > 0 {
+			result.Cards = combo
 			bestHand = result
 		}
 	}
@@ -25,8 +28,119 @@ func EvaluateHand(cards []*card.Card) *HandResult {
 	return bestHand
 }
 
+// EvaluateIDs finds the best 5-card poker hand from 1-7 cards given as
+// card IDs (see card.Card.ID), skipping card code parsing.
+func EvaluateIDs(ids []int) (*HandResult, error) {
+	cards, err := card.FromIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := card.CheckUnique(cards); err != nil {
+		return nil, err
+	}
+	return EvaluateHand(cards), nil
+}
+
+// EvaluateWithBoard finds the best hand from hole and board cards and
+// reports whether the player plays the board. When a complete board ties
+// the best hand, the board's five cards are reported as the hand.
+func EvaluateWithBoard(hole, board []*card.Card) *HandResult {
+	all := make([]*card.Card, 0, len(hole)+len(board))
+	all = append(all, hole...)
+	all = append(all, board...)
+
+	result := EvaluateHand(all)
+	if result == nil || len(board) != 5 {
+		return result
+	}
+
+	if boardResult := EvaluateHand(board); boardResult.Compare(result) == 0 {
+		result.Cards = boardResult.Cards
+		result.PlaysBoard = true
+	}
+	return result
+}
+
+// EvaluateExcluding finds the best 5-card hand from 1-7 cards among the
+// combinations that do not make one of the excluded categories. Excluding
+// Flush and Straight from a made flush, for example, reveals the pair the
+// cards hold otherwise. It returns nil when every combination is excluded.
+func EvaluateExcluding(cards []*card.Card, excluded ...HandRank) *HandResult {
+	if len(cards) < 1 {
+		return nil
+	}
+
+	skip := make(map[HandRank]bool, len(excluded))
+	for _, rank := range excluded {
+		skip[rank] = true
+	}
+
+	combinations := [][]*card.Card{cards}
+	if len(cards) >= 5 {
+		combinations = generateCombinations(cards, 5)
+	}
+
+	var bestHand *HandResult
+	for _, combo := range combinations {
+		result := evaluateFiveCardHand(combo)
+		if skip[result.Rank] {
+			continue
+		}
+		if bestHand == nil || result.Compare(bestHand) > 0 {
+			result.Cards = combo
+			bestHand = result
+		}
+	}
+
+	return bestHand
+}
+
+// EvaluateByCategory finds the best 5-card hand of every category the
+// 1-7 cards can make, keyed by rank. Where EvaluateHand keeps only the
+// overall best, this shows, for example, the best straight and the best
+// three of a kind that a flush beats. Categories no combination makes are
+// absent, and a hand of fewer than five cards has only its own category.
+func EvaluateByCategory(cards []*card.Card) map[HandRank]*HandResult {
+	best := make(map[HandRank]*HandResult)
+	if len(cards) < 1 {
+		return best
+	}
+
+	combinations := [][]*card.Card{cards}
+	if len(cards) >= 5 {
+		combinations = generateCombinations(cards, 5)
+	}
+
+	for _, combo := range combinations {
+		result := evaluateFiveCardHand(combo)
+		if current := best[result.Rank]; current == nil || result.Compare(current) > 0 {
+			result.Cards = combo
+			best[result.Rank] = result
+		}
+	}
+
+	return best
+}
+
 // evaluateFiveCardHand evaluates exactly 5 cards (or fewer for partial hands).
+// Kickers always have the canonical length for the hand's rank. Complete
+// hands go through the evaluation cache when it is enabled.
 func evaluateFiveCardHand(cards []*card.Card) *HandResult {
+	if len(cards) == 5 && cacheEnabled.Load() {
+		return evaluateCached(cards)
+	}
+	return evaluateUncached(cards)
+}
+
+// evaluateUncached is evaluateFiveCardHand without the cache.
+func evaluateUncached(cards []*card.Card) *HandResult {
+	result := rankFiveCardHand(cards)
+	result.Kickers = canonicalKickers(result.Rank, result.Kickers)
+	return result
+}
+
+// rankFiveCardHand finds the rank and kickers of up to 5 cards.
+func rankFiveCardHand(cards []*card.Card) *HandResult {
 	// Sort cards by rank value (highest first)
 	sortedCards := make([]*card.Card, len(cards))
 	copy(sortedCards, cards)
@@ -0,0 +1,53 @@
+package evaluator
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// fuzzCards turns each byte of data into a card: values below 52 pick a
+// card from the deck, repeats included, and the rest give an unknown rank
+// or suit.
+func fuzzCards(data []byte) []*card.Card {
+	cards := make([]*card.Card, len(data))
+	for i, b := range data {
+		switch {
+		case b < 52:
+			cards[i] = &card.Card{Rank: card.RankOrder[b%13], Suit: card.AllSuits[b/13]}
+		case b%2 == 0:
+			cards[i] = &card.Card{Rank: "X", Suit: card.AllSuits[b%4]}
+		default:
+			cards[i] = &card.Card{Rank: card.RankOrder[b%13], Suit: "X"}
+		}
+	}
+	return cards
+}
+
+func FuzzEvaluateHand(f *testing.F) {
+	f.Add([]byte{12, 11, 10, 9, 8})
+	f.Add([]byte{12, 25, 38, 51, 0, 1, 2})
+	f.Add([]byte{0, 0, 0, 0, 0})
+	f.Add([]byte{12, 11, 10, 9, 52, 53})
+	f.Add([]byte{3})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) < 1 || len(data) > 7 {
+			return
+		}
+		cards := fuzzCards(data)
+		result := EvaluateHand(cards)
+		if result == nil {
+			t.Fatalf("EvaluateHand(%v) = nil", cards)
+		}
+		if result.Rank < HighCard || result.Rank > RoyalFlush || result.Label != HandRankNames[result.Rank] {
+			t.Fatalf("EvaluateHand(%v) = %v %q", cards, result.Rank, result.Label)
+		}
+
+		reversed := slices.Clone(cards)
+		slices.Reverse(reversed)
+		if other := EvaluateHand(reversed); result.Compare(other) != 0 {
+			t.Fatalf("EvaluateHand(%v) = %s, but %s in reverse order", cards, result.Label, other.Label)
+		}
+	})
+}
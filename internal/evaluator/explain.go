@@ -0,0 +1,109 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// RankNames maps card ranks to their display names.
+var RankNames = map[card.Rank]string{
+	card.Two:   "Two",
+	card.Three: "Three",
+	card.Four:  "Four",
+	card.Five:  "Five",
+	card.Six:   "Six",
+	card.Seven: "Seven",
+	card.Eight: "Eight",
+	card.Nine:  "Nine",
+	card.Ten:   "Ten",
+	card.Jack:  "Jack",
+	card.Queen: "Queen",
+	card.King:  "King",
+	card.Ace:   "Ace",
+}
+
+// kickerName returns the display name of a kicker rank value.
+func kickerName(value int) string {
+	if value < 0 || value >= len(card.RankOrder) {
+		return "none"
+	}
+	return RankNames[card.RankOrder[value]]
+}
+
+// How a Compare ruling was decided, reported in TieBreak.DecidedBy.
+const (
+	// DecidedByRank: the hands are of different categories.
+	DecidedByRank = "rank"
+	// DecidedByKicker: same category, split by a ranking card.
+	DecidedByKicker = "kicker"
+	// DecidedTie: every ranking card matched.
+	DecidedTie = "tie"
+	// DecidedBoardPlays: a tie in which both hands are the same five
+	// cards, so neither player's hole cards play.
+	DecidedBoardPlays = "board plays"
+)
+
+// TieBreak is the structured reason for a Compare ruling, for logging
+// and auditing. Winner and Loser are set unless the hands tie.
+type TieBreak struct {
+	// Result is what Compare returns: 1, -1, or 0.
+	Result    int
+	DecidedBy string
+	// Position is the 1-based index into Kickers of the deciding card
+	// when DecidedBy is DecidedByKicker, and 0 otherwise.
+	Position int
+	// WinningCard and LosingCard name the deciding ranks, e.g. "Ace" and
+	// "Jack", when decided by a kicker.
+	WinningCard string
+	LosingCard  string
+	Winner      *HandResult
+	Loser       *HandResult
+}
+
+// TieBreak explains how h1.Compare(h2) is decided. It follows Compare
+// exactly: the category first, then Kickers in order.
+func (h1 *HandResult) TieBreak(h2 *HandResult) TieBreak {
+	if h1.Rank != h2.Rank {
+		if h1.Rank > h2.Rank {
+			return TieBreak{Result: 1, DecidedBy: DecidedByRank, Winner: h1, Loser: h2}
+		}
+		return TieBreak{Result: -1, DecidedBy: DecidedByRank, Winner: h2, Loser: h1}
+	}
+
+	for i := 0; i < len(h1.Kickers) && i < len(h2.Kickers); i++ {
+		if h1.Kickers[i] == h2.Kickers[i] {
+			continue
+		}
+		result := TieBreak{Result: 1, DecidedBy: DecidedByKicker, Position: i + 1, Winner: h1, Loser: h2}
+		if h2.Kickers[i] > h1.Kickers[i] {
+			result.Result, result.Winner, result.Loser = -1, h2, h1
+		}
+		result.WinningCard = kickerName(result.Winner.Kickers[i])
+		result.LosingCard = kickerName(result.Loser.Kickers[i])
+		return result
+	}
+
+	if len(h1.Cards) == 5 && card.NewSet(h1.Cards...) == card.NewSet(h2.Cards...) {
+		return TieBreak{DecidedBy: DecidedBoardPlays}
+	}
+	return TieBreak{DecidedBy: DecidedTie}
+}
+
+// ExplainCompare describes in words why h1 beats, loses to, or ties h2.
+func ExplainCompare(h1, h2 *HandResult) string {
+	tieBreak := h1.TieBreak(h2)
+	label := "Hand A"
+	if tieBreak.Result < 0 {
+		label = "Hand B"
+	}
+
+	switch tieBreak.DecidedBy {
+	case DecidedByRank:
+		return fmt.Sprintf("%s wins: %s beats %s", label, tieBreak.Winner.Label, tieBreak.Loser.Label)
+	case DecidedByKicker:
+		return fmt.Sprintf("%s wins: both have %s, decided by tiebreak card %d (%s over %s)",
+			label, h1.Label, tieBreak.Position, tieBreak.WinningCard, tieBreak.LosingCard)
+	}
+	return fmt.Sprintf("Tie: both have %s with the same ranking cards", h1.Label)
+}
@@ -0,0 +1,65 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// mustCards parses a whitespace-separated list of card codes such as
+// "As Ks 7d", failing the test on an invalid code.
+func mustCards(t testing.TB, codes string) []*card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(strings.Fields(codes))
+	if err != nil {
+		t.Fatalf("mustCards(%q): %v", codes, err)
+	}
+	return cards
+}
+
+// rulings are showdowns with a known result, as a floor ruling would
+// settle them. want is Compare of the first hand against the second.
+var rulings = []struct {
+	name          string
+	board         string
+	first, second string
+	want          int
+	firstLabel    string
+	secondLabel   string
+}{
+	{"dead man's hand beats a lower two pair", "As 8s 8d 4c 2h", "Ac 9c", "Kd Kh", 1, "Two Pair", "Two Pair"},
+	{"kicker decides top pair", "As 9c 7h 4d 2s", "Ah Kc", "Ad Qd", 1, "One Pair", "One Pair"},
+	{"board pair counterfeits the lower pair", "Kc Kd Qh Qs 5c", "2c 2d", "Ah 3c", -1, "Two Pair", "Two Pair"},
+	{"royal flush on board splits", "As Ks Qs Js Ts", "2c 2d", "Ah Kd", 0, "Royal Flush", "Royal Flush"},
+	{"broadway on board splits", "Ah Kd Qc Js Th", "9s 9d", "2c 3c", 0, "Straight", "Straight"},
+	{"wheel loses to six-high straight", "5d 4c 3h 2s Kd", "Ac Qh", "6s 9h", -1, "Straight", "Straight"},
+	{"higher flush card wins", "Kh 9h 6h 3h 2c", "Ah 4c", "Qh Jh", 1, "Flush", "Flush"},
+	{"trips over the pair makes the full house", "Ah As Kd 7c 2s", "Ac Kc", "Kh Ks", 1, "Full House", "Full House"},
+	{"quads on board play the best kicker", "9c 9d 9h 9s Kd", "Ac 2d", "Qc Jd", 1, "Four of a Kind", "Four of a Kind"},
+	{"quads on board with the king kicker split", "9c 9d 9h 9s Kd", "Qc Jd", "8c 7d", 0, "Four of a Kind", "Four of a Kind"},
+	{"steel wheel beats a six-high straight", "Ah 2h 3h 4h Ks", "5h 7c", "5c 6d", 1, "Straight Flush", "Straight"},
+	{"sixth card does not play", "Ac Ad Kh Ks Qc", "Jd 9c", "9h 8h", 0, "Two Pair", "Two Pair"},
+}
+
+// TestRulings checks evaluation and comparison against the golden
+// rulings.
+func TestRulings(t *testing.T) {
+	for _, tc := range rulings {
+		t.Run(tc.name, func(t *testing.T) {
+			board := mustCards(t, tc.board)
+			first := EvaluateHand(append(mustCards(t, tc.first), board...))
+			second := EvaluateHand(append(mustCards(t, tc.second), board...))
+
+			if first.Label != tc.firstLabel || second.Label != tc.secondLabel {
+				t.Errorf("labels = %s, %s; want %s, %s", first.Label, second.Label, tc.firstLabel, tc.secondLabel)
+			}
+			if got := first.Compare(second); got != tc.want {
+				t.Errorf("Compare = %d, want %d", got, tc.want)
+			}
+			if got := second.Compare(first); got != -tc.want {
+				t.Errorf("reverse Compare = %d, want %d", got, -tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package evaluator
+
+// kickerCounts is the number of kickers that rank each hand category:
+// the values that Compare looks at after the rank, such as the pair and
+// three side cards of one pair.
+var kickerCounts = [RoyalFlush + 1]int{
+	HighCard:      5,
+	OnePair:       4,
+	TwoPair:       3,
+	ThreeOfAKind:  3,
+	Straight:      1,
+	Flush:         5,
+	FullHouse:     2,
+	FourOfAKind:   2,
+	StraightFlush: 1,
+	RoyalFlush:    0,
+}
+
+// missingKicker fills the kickers a partial hand of fewer than five cards
+// lacks. It is below every card, so a missing kicker always loses, as it
+// does in Key.
+const missingKicker = -1
+
+// canonicalKickers returns kickers at the canonical length for rank, so
+// hands of the same rank always compare the same number of kickers however
+// many cards they were made from. Hands of five or more cards already have
+// that length and are returned unchanged.
+func canonicalKickers(rank HandRank, kickers []int) []int {
+	if rank < HighCard || rank > RoyalFlush {
+		return kickers
+	}
+	n := kickerCounts[rank]
+	if len(kickers) == n {
+		return kickers
+	}
+	if len(kickers) > n {
+		return kickers[:n]
+	}
+
+	padded := make([]int, n)
+	copy(padded, kickers)
+	for i := len(kickers); i < n; i++ {
+		padded[i] = missingKicker
+	}
+	return padded
+}
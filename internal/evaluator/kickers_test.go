@@ -0,0 +1,56 @@
+package evaluator
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// TestSameHandFromMoreCards builds each best hand from five cards, then
+// adds cards that cannot improve it: the results must compare equal and
+// carry the same kickers.
+func TestSameHandFromMoreCards(t *testing.T) {
+	tests := []struct {
+		best, extra string
+	}{
+		{"Ah Jd 9c 6s 4h", "2c 3d"},
+		{"Kh Kd 9c 6s 4h", "2c 3d"},
+		{"Kh Kd 9c 9s 3h", "2c 2d"},
+		{"Qh Qd Qc 6s 4h", "2c 3d"},
+		{"9h 8d 7c 6s 5h", "2c 3d"},
+		{"Ah Jh 9h 6h 3h", "2h 4c"},
+		{"Th Td Tc 6s 6h", "2c 6d"},
+		{"7h 7d 7c 7s Ah", "Kc Qd"},
+		{"9h 8h 7h 6h 5h", "4c 3d"},
+		{"Ah Kh Qh Jh Th", "9h 8h"},
+	}
+
+	for _, tt := range tests {
+		five := mustCards(t, tt.best)
+		want := EvaluateHand(five)
+		extra := mustCards(t, tt.extra)
+		for n := 1; n <= len(extra); n++ {
+			cards := append(append([]*card.Card{}, five...), extra[:n]...)
+			got := EvaluateHand(cards)
+			if got.Compare(want) != 0 || got.Key() != want.Key() || len(got.Kickers) != len(want.Kickers) {
+				t.Errorf("%s from %d cards = %s %v, from 5 = %s %v", tt.best, len(cards), got.Label, got.Kickers, want.Label, want.Kickers)
+			}
+		}
+	}
+}
+
+func TestKickersHaveCanonicalLength(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, hand := range randomHands(2000, rng) {
+		if len(hand.Kickers) != kickerCounts[hand.Rank] {
+			t.Fatalf("%s %v has %d kickers, want %d", hand.Label, hand.Kickers, len(hand.Kickers), kickerCounts[hand.Rank])
+		}
+	}
+	for _, codes := range []string{"As", "As Ad", "As Ks Qd", "7s 7d 7c 2h"} {
+		hand := EvaluateHand(mustCards(t, codes))
+		if len(hand.Kickers) != kickerCounts[hand.Rank] {
+			t.Errorf("%s: %s %v has %d kickers, want %d", codes, hand.Label, hand.Kickers, len(hand.Kickers), kickerCounts[hand.Rank])
+		}
+	}
+}
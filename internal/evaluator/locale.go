@@ -0,0 +1,92 @@
+package evaluator
+
+import (
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is used when a requested locale has no name table.
+const DefaultLocale = "en"
+
+var (
+	localesMu sync.RWMutex
+
+	// handRankLocales maps locale codes to hand rank display names.
+	handRankLocales = map[string]map[HandRank]string{
+		"en": HandRankNames,
+		"es": {
+			HighCard:      "Carta Alta",
+			OnePair:       "Pareja",
+			TwoPair:       "Doble Pareja",
+			ThreeOfAKind:  "Trío",
+			Straight:      "Escalera",
+			Flush:         "Color",
+			FullHouse:     "Full",
+			FourOfAKind:   "Póker",
+			StraightFlush: "Escalera de Color",
+			RoyalFlush:    "Escalera Real",
+		},
+		"fr": {
+			HighCard:      "Carte Haute",
+			OnePair:       "Paire",
+			TwoPair:       "Double Paire",
+			ThreeOfAKind:  "Brelan",
+			Straight:      "Quinte",
+			Flush:         "Couleur",
+			FullHouse:     "Full",
+			FourOfAKind:   "Carré",
+			StraightFlush: "Quinte Flush",
+			RoyalFlush:    "Quinte Flush Royale",
+		},
+		"de": {
+			HighCard:      "Höchste Karte",
+			OnePair:       "Ein Paar",
+			TwoPair:       "Zwei Paare",
+			ThreeOfAKind:  "Drilling",
+			Straight:      "Straße",
+			Flush:         "Flush",
+			FullHouse:     "Full House",
+			FourOfAKind:   "Vierling",
+			StraightFlush: "Straight Flush",
+			RoyalFlush:    "Royal Flush",
+		},
+	}
+)
+
+// RegisterLocale adds or replaces the hand rank names for a locale.
+// Ranks missing from names fall back to English.
+func RegisterLocale(locale string, names map[HandRank]string) {
+	table := make(map[HandRank]string, len(names))
+	for rank, name := range names {
+		table[rank] = name
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	handRankLocales[normalizeLocale(locale)] = table
+}
+
+// HandRankName returns the display name of a rank in the given locale.
+// Regional locales such as "es-MX" fall back to their base language,
+// and unknown locales or ranks fall back to English.
+func HandRankName(rank HandRank, locale string) string {
+	locale = normalizeLocale(locale)
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	if name, ok := handRankLocales[locale][rank]; ok {
+		return name
+	}
+	if i := strings.Index(locale, "-"); i > 0 {
+		if name, ok := handRankLocales[locale[:i]][rank]; ok {
+			return name
+		}
+	}
+	return HandRankNames[rank]
+}
+
+// normalizeLocale lowercases a locale and uses "-" as its separator.
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
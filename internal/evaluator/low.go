@@ -0,0 +1,82 @@
+package evaluator
+
+import (
+	"strings"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// lowQualifier is the highest ace-low rank value a qualifying low may
+// hold: an eight.
+const lowQualifier = 7
+
+// LowResult contains the evaluation result of an eight-or-better low hand.
+type LowResult struct {
+	// Cards is the five cards of the low, highest first.
+	Cards []*card.Card
+	// Label names the low by its ranks, such as "8-6-4-2-A".
+	Label string
+	// Ranks holds ace-low rank values (A=0 ... 8=7), highest first.
+	Ranks []int
+}
+
+// EvaluateLow finds the best ace-to-five low among the cards: five cards
+// of distinct ranks, eight or lower, where straights and flushes do not
+// count against the hand and the lowest highest card wins. It returns nil
+// when the cards hold no qualifying low.
+func EvaluateLow(cards []*card.Card) *LowResult {
+	// The best low is a card of each of the five lowest distinct ranks,
+	// so one card per rank is enough.
+	var byRank [lowQualifier + 1]*card.Card
+	for _, c := range cards {
+		if value := aceLowValue(c); value <= lowQualifier && byRank[value] == nil {
+			byRank[value] = c
+		}
+	}
+
+	result := &LowResult{}
+	for value, c := range byRank {
+		if c != nil && len(result.Cards) < 5 {
+			result.Cards = append([]*card.Card{c}, result.Cards...)
+			result.Ranks = append([]int{value}, result.Ranks...)
+		}
+	}
+	if len(result.Cards) < 5 {
+		return nil
+	}
+
+	names := make([]string, len(result.Cards))
+	for i, c := range result.Cards {
+		names[i] = string(c.Rank)
+	}
+	result.Label = strings.Join(names, "-")
+	return result
+}
+
+// Compare compares two low results.
+// Returns: 1 if l1 wins, -1 if l2 wins, 0 if tie.
+func (l1 *LowResult) Compare(l2 *LowResult) int {
+	// The lower highest card wins, then the next, and so on
+	for i := range l1.Ranks {
+		if l1.Ranks[i] < l2.Ranks[i] {
+			return 1
+		}
+		if l1.Ranks[i] > l2.Ranks[i] {
+			return -1
+		}
+	}
+	return 0
+}
+
+// HiLoResult is the best high hand and the best qualifying low from the
+// same cards, as split in hi-lo games.
+type HiLoResult struct {
+	High *HandResult
+	// Low is nil when no low qualifies, so the high hand takes the pot.
+	Low *LowResult
+}
+
+// EvaluateHiLo evaluates the cards for both halves of a hi-lo pot.
+func EvaluateHiLo(cards []*card.Card) *HiLoResult {
+	return &HiLoResult{High: EvaluateHand(cards), Low: EvaluateLow(cards)}
+}
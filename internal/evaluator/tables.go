@@ -0,0 +1,34 @@
+package evaluator
+
+import "github.com/KyleKDang/poker-odds-engine/internal/card"
+
+// straightTable maps every 13-bit rank mask to the high rank value of its
+// best straight, or -1 if the ranks hold no straight.
+var straightTable [1 << 13]int8
+
+// singleSuitTable reports, for every 4-bit mask of suits present, whether
+// exactly one suit appears.
+var singleSuitTable [1 << 4]bool
+
+func init() {
+	for mask := range straightTable {
+		straightTable[mask] = int8(straightHigh(uint16(mask)))
+	}
+	for mask := range singleSuitTable {
+		singleSuitTable[mask] = mask != 0 && mask&(mask-1) == 0
+	}
+}
+
+// suitMask returns a bitmask of the suits present in cards. An unknown
+// suit sets every bit, so a hand holding one is never a flush.
+func suitMask(cards []*card.Card) uint8 {
+	var mask uint8
+	for _, c := range cards {
+		s := card.SuitIndex(c.Suit)
+		if s < 0 {
+			return 1<<len(card.AllSuits) - 1
+		}
+		mask |= 1 << s
+	}
+	return mask
+}
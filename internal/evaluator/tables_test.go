@@ -0,0 +1,105 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// referenceStraight is isStraight as it was before the lookup tables: it
+// sorts the distinct rank values and looks for five in a row, then the
+// wheel.
+func referenceStraight(cards []*card.Card) (bool, int) {
+	if len(cards) < 5 {
+		return false, 0
+	}
+
+	present := make(map[int]bool)
+	for _, c := range cards {
+		present[c.RankValue()] = true
+	}
+	values := make([]int, 0, len(present))
+	for v := range present {
+		values = append(values, v)
+	}
+	for i := 0; i < len(values); i++ {
+		for j := i + 1; j < len(values); j++ {
+			if values[j] > values[i] {
+				values[i], values[j] = values[j], values[i]
+			}
+		}
+	}
+
+	for i := 0; i <= len(values)-5; i++ {
+		if values[i]-values[i+4] == 4 {
+			return true, values[i]
+		}
+	}
+	if present[12] && present[0] && present[1] && present[2] && present[3] {
+		return true, 3
+	}
+	return false, 0
+}
+
+// maskCards returns one spade for each rank in a 13-bit mask.
+func maskCards(mask int) []*card.Card {
+	var cards []*card.Card
+	for v, rank := range card.RankOrder {
+		if mask&(1<<v) != 0 {
+			cards = append(cards, &card.Card{Rank: rank, Suit: card.Spades})
+		}
+	}
+	return cards
+}
+
+func TestStraightTableMatchesReference(t *testing.T) {
+	for mask := 0; mask < 1<<13; mask++ {
+		cards := maskCards(mask)
+		gotOK, gotHigh := isStraight(cards)
+		wantOK, wantHigh := referenceStraight(cards)
+		if gotOK != wantOK || gotHigh != wantHigh {
+			t.Errorf("isStraight(%v) = %v, %d, want %v, %d", cards, gotOK, gotHigh, wantOK, wantHigh)
+		}
+	}
+}
+
+func TestIsFlush(t *testing.T) {
+	// Every way to suit five cards, by a base-4 counter over the suits.
+	for n := 0; n < 1<<10; n++ {
+		cards := make([]*card.Card, 5)
+		same := true
+		for i := range cards {
+			suit := card.AllSuits[n>>(2*i)&3]
+			cards[i] = &card.Card{Rank: card.RankOrder[i], Suit: suit}
+			same = same && suit == cards[0].Suit
+		}
+		if got := isFlush(cards); got != same {
+			t.Errorf("isFlush(%v) = %v, want %v", cards, got, same)
+		}
+	}
+
+	hand := mustCards(t, "Ah Kh 9h 7h")
+	for _, unknown := range []card.Suit{"X", ""} {
+		cards := append(hand, &card.Card{Rank: card.Two, Suit: unknown})
+		if isFlush(cards) {
+			t.Errorf("isFlush(%v) = true with unknown suit %q", cards, unknown)
+		}
+		if result := EvaluateHand(cards); result.Rank == Flush {
+			t.Errorf("EvaluateHand(%v) = %s with unknown suit %q", cards, result.Label, unknown)
+		}
+	}
+}
+
+func BenchmarkIsStraight(b *testing.B) {
+	hand := maskCards(0b1_0000_0011_1101)
+	for i := 0; i < b.N; i++ {
+		isStraight(hand)
+	}
+}
+
+func BenchmarkIsStraightReference(b *testing.B) {
+	hand := maskCards(0b1_0000_0011_1101)
+	for i := 0; i < b.N; i++ {
+		referenceStraight(hand)
+	}
+}
@@ -39,6 +39,11 @@ type HandResult struct {
 	Rank    HandRank
 	Label   string
 	Kickers []int
+	// Cards holds the cards that form the hand, at most five.
+	Cards []*card.Card
+	// PlaysBoard is set by EvaluateWithBoard when the board alone makes a
+	// hand as strong as the best one, so the hole cards do not play.
+	PlaysBoard bool
 }
 
 // Compare compares two hand results.
@@ -64,6 +69,22 @@ func (h1 *HandResult) Compare (h2 *HandResult) int {
 	return 0
 }
 
+// Key packs the rank and kickers into one integer so that, for hands of
+// five or more cards, a.Key() > b.Key() exactly when a beats b and equal
+// keys tie. Collections of hands can then be sorted by key. Each kicker
+// takes four bits, stored as its value plus one so missing kickers of
+// partial hands sort lowest.
+func (h *HandResult) Key() uint64 {
+	key := uint64(h.Rank)
+	for i := 0; i < 5; i++ {
+		key <<= 4
+		if i < len(h.Kickers) {
+			key |= uint64(h.Kickers[i] + 1)
+		}
+	}
+	return key
+}
+
 // rankCounts counts how many of each rank appear in the hand.
 func rankCounts(cards []*card.Card) map[card.Rank]int {
 	counts := make(map[card.Rank]int)
@@ -78,13 +99,7 @@ func isFlush(cards []*card.Card) bool {
 	if len(cards) < 5 {
 		return false
 	}
-	suit := cards[0].Suit
-	for _, c := range cards[1:] {
-		if c.Suit != suit {
-			return false
-		}
-	}
-	return true
+	return singleSuitTable[suitMask(cards)]
 }
 
 // isStraight checks if cards form a straight.
@@ -94,38 +109,9 @@ func isStraight(cards []*card.Card) (bool, int) {
 		return false, 0
 	}
 
-	// Get unique rank values
-	rankValues := make(map[int]bool)
-	for _, c := range cards {
-		rankValues[c.RankValue()] = true
-	}
-
-	// Convert to sorted slice (descending)
-	values := make([]int, 0, len(rankValues))
-	for v := range rankValues {
-		values = append(values, v)
-	}
-
-	// Simple bubble sort (descending)
-	for i := 0; i < len(values); i++ {
-		for j := i + 1; j < len(values); j++ {
-			if values[j] > values[i] {
-				values[i], values[j] = values[j], values[i]
-			}
-		}
-	}
-
-	// Check for 5 consecutive values
-	for i := 0; i <= len(values)-5; i++ {
-		if values[i]-values[i+4] == 4 {
-			return true, values[i]
-		}
-	}
-
-	// Check for ace-low straight (A-2-3-4-5)
-	if rankValues[12] && rankValues[0] && rankValues[1] && rankValues[2] && rankValues[3] {
-		return true, 3
+	high := straightTable[rankMask(cards)]
+	if high < 0 {
+		return false, 0
 	}
-
-	return false, 0
+	return true, int(high)
 }
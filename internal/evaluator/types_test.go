@@ -0,0 +1,51 @@
+package evaluator
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// randomHands evaluates n random hands of 5 to 7 cards.
+func randomHands(n int, rng *rand.Rand) []*HandResult {
+	hands := make([]*HandResult, n)
+	for i := range hands {
+		deck := card.NewDeck()
+		card.Shuffle(deck, rng)
+		hands[i] = EvaluateHand(deck[:5+rng.Intn(3)])
+	}
+	return hands
+}
+
+func TestKeyMatchesCompare(t *testing.T) {
+	hands := randomHands(1500, rand.New(rand.NewSource(1)))
+	for _, codes := range []string{"As Ks Qs Js Ts", "5d 4c 3h 2s Ad", "6s 5d 4c 3h 2s", "Kh Kd 9c 9s 2d"} {
+		hands = append(hands, EvaluateHand(mustCards(t, codes)))
+	}
+
+	sign := func(a, b uint64) int {
+		switch {
+		case a > b:
+			return 1
+		case a < b:
+			return -1
+		}
+		return 0
+	}
+	for _, a := range hands {
+		for _, b := range hands {
+			if got, want := sign(a.Key(), b.Key()), a.Compare(b); got != want {
+				t.Fatalf("%s %v against %s %v: keys order %d, Compare %d", a.Label, a.Kickers, b.Label, b.Kickers, got, want)
+			}
+		}
+	}
+
+	sort.Slice(hands, func(i, j int) bool { return hands[i].Key() < hands[j].Key() })
+	for i := 1; i < len(hands); i++ {
+		if hands[i].Compare(hands[i-1]) < 0 {
+			t.Fatalf("sorted by key, %s %v comes after %s %v", hands[i].Label, hands[i].Kickers, hands[i-1].Label, hands[i-1].Kickers)
+		}
+	}
+}
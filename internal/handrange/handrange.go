@@ -0,0 +1,242 @@
+// Package handrange parses poker hand range notation into weighted combos.
+package handrange
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// Combo is a specific two-card starting hand with a sampling weight.
+type Combo struct {
+	Cards  [2]*card.Card
+	Weight float64
+}
+
+// Range is a set of weighted starting hand combos.
+type Range []Combo
+
+// Parse parses comma-separated range notation into combos.
+//
+// Supported forms:
+//   - pairs: "QQ", "QQ+" (QQ-AA), "99-66"
+//   - suited/offsuit hands: "AKs", "AKo", "AK" (both), "ATs+" (ATs-AKs), "K9o-K6o"
+//   - exact combos: "AhKh"
+//
+// Any entry may carry a positive, finite weight suffix such as "AKo:0.5";
+// the default is 1.
+// When a combo is listed more than once, the last weight wins.
+func Parse(notation string) (Range, error) {
+	index := make(map[[2]string]int)
+	var r Range
+
+	for _, token := range strings.Split(notation, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		weight := 1.0
+		if i := strings.Index(token, ":"); i >= 0 {
+			w, err := strconv.ParseFloat(token[i+1:], 64)
+			if err != nil || w <= 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+				return nil, fmt.Errorf("invalid weight in %q", token)
+			}
+			weight = w
+			token = token[:i]
+		}
+
+		combos, err := expand(token)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cards := range combos {
+			key := [2]string{cards[0].String(), cards[1].String()}
+			if i, ok := index[key]; ok {
+				r[i].Weight = weight
+				continue
+			}
+			index[key] = len(r)
+			r = append(r, Combo{Cards: cards, Weight: weight})
+		}
+	}
+
+	if len(r) == 0 {
+		return nil, fmt.Errorf("range %q contains no hands", notation)
+	}
+	return r, nil
+}
+
+// Without returns the combos that share no card with cards.
+func (r Range) Without(cards []*card.Card) Range {
+	result := make(Range, 0, len(r))
+	for _, combo := range r {
+		blocked := false
+		for _, c := range cards {
+			if combo.Cards[0].Equal(c) || combo.Cards[1].Equal(c) {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			result = append(result, combo)
+		}
+	}
+	return result
+}
+
+// expand converts one range token (without weight) into combos.
+func expand(token string) ([][2]*card.Card, error) {
+	upper := strings.ToUpper(token)
+
+	// Exact combo such as "AhKh".
+	if len(upper) == 4 && !strings.ContainsAny(upper, "+-") {
+		cards, err := card.ParseCards([]string{upper[0:2], upper[2:4]})
+		if err == nil {
+			if cards[0].Equal(cards[1]) {
+				return nil, fmt.Errorf("invalid combo %q: duplicate card", token)
+			}
+			return [][2]*card.Card{{cards[0], cards[1]}}, nil
+		}
+	}
+
+	if i := strings.Index(upper, "-"); i >= 0 {
+		return expandSpan(token, upper[:i], upper[i+1:])
+	}
+
+	plus := strings.HasSuffix(upper, "+")
+	hand, err := parseHand(strings.TrimSuffix(upper, "+"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid range entry %q: %v", token, err)
+	}
+
+	if !plus {
+		return hand.combos(), nil
+	}
+	if hand.high == hand.low {
+		return pairSpan(hand.low, len(card.RankOrder)-1), nil
+	}
+	return kickerSpan(hand, hand.low, hand.high-1), nil
+}
+
+// expandSpan handles "99-66" and "K9o-K6o" style tokens.
+func expandSpan(token, from, to string) ([][2]*card.Card, error) {
+	a, err := parseHand(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range entry %q: %v", token, err)
+	}
+	b, err := parseHand(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range entry %q: %v", token, err)
+	}
+
+	if a.high == a.low && b.high == b.low {
+		lo, hi := a.low, b.low
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		return pairSpan(lo, hi), nil
+	}
+
+	if a.high != b.high || a.suited != b.suited || a.offsuit != b.offsuit ||
+		a.high == a.low || b.high == b.low {
+		return nil, fmt.Errorf("invalid range entry %q: ends must share the high card and suitedness", token)
+	}
+
+	lo, hi := a.low, b.low
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return kickerSpan(a, lo, hi), nil
+}
+
+// hand is a starting hand class such as "AKs", "T9o", "QQ" or "AJ".
+type hand struct {
+	high, low       int
+	suited, offsuit bool
+}
+
+// parseHand parses a two- or three-character hand class.
+func parseHand(s string) (hand, error) {
+	if len(s) != 2 && len(s) != 3 {
+		return hand{}, fmt.Errorf("expected a hand like AKs, got %q", s)
+	}
+
+	high := rankValue(s[0])
+	low := rankValue(s[1])
+	if high < 0 || low < 0 {
+		return hand{}, fmt.Errorf("invalid rank in %q", s)
+	}
+	if low > high {
+		high, low = low, high
+	}
+
+	h := hand{high: high, low: low}
+	if len(s) == 3 {
+		switch s[2] {
+		case 'S':
+			h.suited = true
+		case 'O':
+			h.offsuit = true
+		default:
+			return hand{}, fmt.Errorf("invalid suitedness in %q", s)
+		}
+		if high == low {
+			return hand{}, fmt.Errorf("pairs cannot be suited or offsuit: %q", s)
+		}
+	}
+	return h, nil
+}
+
+// combos returns every specific combo of the hand class.
+func (h hand) combos() [][2]*card.Card {
+	var result [][2]*card.Card
+	for i, s1 := range card.AllSuits {
+		for j, s2 := range card.AllSuits {
+			if h.high == h.low && j <= i {
+				continue
+			}
+			if h.suited && s1 != s2 || h.offsuit && s1 == s2 {
+				continue
+			}
+			result = append(result, [2]*card.Card{
+				{Rank: card.RankOrder[h.high], Suit: s1},
+				{Rank: card.RankOrder[h.low], Suit: s2},
+			})
+		}
+	}
+	return result
+}
+
+// pairSpan returns the combos of every pair from rank value lo to hi.
+func pairSpan(lo, hi int) [][2]*card.Card {
+	var result [][2]*card.Card
+	for v := lo; v <= hi; v++ {
+		result = append(result, hand{high: v, low: v}.combos()...)
+	}
+	return result
+}
+
+// kickerSpan returns the combos of h with every kicker from lo to hi.
+func kickerSpan(h hand, lo, hi int) [][2]*card.Card {
+	var result [][2]*card.Card
+	for v := lo; v <= hi; v++ {
+		h.low = v
+		result = append(result, h.combos()...)
+	}
+	return result
+}
+
+// rankValue returns the value of a rank character, or -1 if invalid.
+func rankValue(b byte) int {
+	for i, r := range card.RankOrder {
+		if string(r) == string(b) {
+			return i
+		}
+	}
+	return -1
+}
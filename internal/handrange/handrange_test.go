@@ -0,0 +1,149 @@
+package handrange
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// class returns the hand class of a combo, such as "AKs", "T9o" or "QQ".
+func class(c Combo) string {
+	hi, lo := c.Cards[0], c.Cards[1]
+	if lo.RankValue() > hi.RankValue() {
+		hi, lo = lo, hi
+	}
+	name := string(hi.Rank) + string(lo.Rank)
+	switch {
+	case hi.Rank == lo.Rank:
+		return name
+	case hi.Suit == lo.Suit:
+		return name + "s"
+	default:
+		return name + "o"
+	}
+}
+
+// classes returns the distinct hand classes of r in sorted order.
+func classes(r Range) string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, c := range r {
+		if name := class(c); !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, " ")
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		notation string
+		combos   int
+		classes  string
+	}{
+		{"QQ", 6, "QQ"},
+		{"QQ+", 18, "AA KK QQ"},
+		{"99-66", 24, "66 77 88 99"},
+		{"66-99", 24, "66 77 88 99"},
+		{"22+", 78, "22 33 44 55 66 77 88 99 AA JJ KK QQ TT"},
+		{"AKs", 4, "AKs"},
+		{"AKo", 12, "AKo"},
+		{"AK", 16, "AKo AKs"},
+		{"KA", 16, "AKo AKs"},
+		{"aks", 4, "AKs"},
+		{"ATs+", 16, "AJs AKs AQs ATs"},
+		{"T9o+", 12, "T9o"},
+		{"K9o-K6o", 48, "K6o K7o K8o K9o"},
+		{"K6s-K9s", 16, "K6s K7s K8s K9s"},
+		{"AhKh", 1, "AKs"},
+		{"AKs, QQ", 10, "AKs QQ"},
+		{" AKs ,, AKs ", 4, "AKs"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.notation)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.notation, err)
+			continue
+		}
+		if len(r) != tt.combos {
+			t.Errorf("Parse(%q) has %d combos, want %d", tt.notation, len(r), tt.combos)
+		}
+		if got := classes(r); got != tt.classes {
+			t.Errorf("Parse(%q) classes = %q, want %q", tt.notation, got, tt.classes)
+		}
+	}
+}
+
+func TestParseWeights(t *testing.T) {
+	r, err := Parse("AKs:0.5, QQ, AhKh:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 10 {
+		t.Fatalf("got %d combos, want 10", len(r))
+	}
+	for _, c := range r {
+		want := 1.0
+		switch {
+		case c.Cards[0].String() == "AH" && c.Cards[1].String() == "KH":
+			want = 2
+		case class(c) == "AKs":
+			want = 0.5
+		}
+		if c.Weight != want {
+			t.Errorf("%v%v weight = %v, want %v", c.Cards[0], c.Cards[1], c.Weight, want)
+		}
+	}
+}
+
+func TestParseRejectsBadTokens(t *testing.T) {
+	for _, notation := range []string{
+		"",
+		" , ",
+		"AKx",
+		"ZZ",
+		"AKss",
+		"QQs",
+		"AhAh",
+		"AKs-QJs",
+		"AKs-AQo",
+		"QQ-AKs",
+		"AK:",
+		"AK:abc",
+		"AK:0",
+		"AK:-1",
+		"AK:NaN",
+		"AK:Inf",
+		"AK:-Inf",
+		"AK:1e400",
+	} {
+		if r, err := Parse(notation); err == nil {
+			t.Errorf("Parse(%q) = %d combos, want an error", notation, len(r))
+		}
+	}
+}
+
+func TestWithout(t *testing.T) {
+	r, err := Parse("AA, KK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockers, err := Parse("AhKd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	left := r.Without(blockers[0].Cards[:])
+	if len(left) != 6 {
+		t.Fatalf("Without(Ah Kd) leaves %d combos, want 6", len(left))
+	}
+	for _, c := range left {
+		for _, blocked := range blockers[0].Cards {
+			if c.Cards[0].Equal(blocked) || c.Cards[1].Equal(blocked) {
+				t.Errorf("combo %v%v kept despite holding %v", c.Cards[0], c.Cards[1], blocked)
+			}
+		}
+	}
+}
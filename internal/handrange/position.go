@@ -0,0 +1,95 @@
+package handrange
+
+import (
+	"fmt"
+	"strings"
+)
+
+// totalCombos is the number of two-card starting hands.
+const totalCombos = 1326
+
+// openingCharts is the built-in opening range chart for a six-handed game:
+// the hands each position raises first in with, strongest first. Early
+// positions favor high cards; late positions open wider and reach small
+// pairs, suited connectors and weak aces sooner. The full charts open
+// roughly 15% (UTG), 19% (HJ), 25% (CO), 44% (BTN) and 33% (SB) of hands.
+var openingCharts = map[string]string{
+	"UTG": "AA,KK,QQ,AKs,JJ,AKo,AQs,TT,AJs,KQs,AQo,99,ATs,KJs,88,QJs,KTs,AJo," +
+		"KQo,A5s,77,QTs,JTs,A4s,A9s,66,T9s,K9s,A8s,55,ATo,KJo,98s,A3s",
+	"HJ": "AA,KK,QQ,AKs,JJ,AKo,AQs,TT,AJs,KQs,AQo,99,ATs,KJs,88,QJs,KTs,AJo," +
+		"KQo,A5s,77,QTs,JTs,A9s,A4s,66,T9s,K9s,A8s,KJo,ATo,55,98s,A3s,A7s," +
+		"Q9s,J9s,A2s,A6s,44,87s,QJo",
+	"CO": "AA,KK,QQ,JJ,AKs,TT,AKo,AQs,99,AJs,KQs,88,ATs,AQo,KJs,QJs,77,KTs," +
+		"JTs,AJo,A9s,KQo,QTs,A5s,66,A8s,T9s,K9s,A4s,ATo,KJo,55,A7s,98s,J9s," +
+		"A3s,Q9s,A6s,QJo,A2s,44,87s,KTo,T8s,K8s,JTo,33,QTo,76s,22,97s,A9o," +
+		"K7s,J8s,65s",
+	"BTN": "AA,KK,QQ,JJ,AKs,TT,AKo,AQs,99,AJs,KQs,88,ATs,AQo,KJs,QJs,77,KTs," +
+		"JTs,A9s,AJo,KQo,QTs,66,A8s,A5s,T9s,K9s,ATo,KJo,A7s,55,A4s,J9s,98s," +
+		"QJo,A6s,A3s,Q9s,44,KTo,A2s,87s,T8s,K8s,JTo,QTo,33,A9o,76s,K7s,97s," +
+		"J8s,22,65s,K6s,A8o,Q8s,K5s,86s,T7s,K9o,54s,A7o,K4s,Q9o,T9o,J9o,75s," +
+		"A5o,K3s,Q7s,96s,A6o,K2s,64s,A4o,Q6s,J7s,53s,85s,A3o,Q5s,98o,K8o," +
+		"A2o,T8o,Q4s,43s",
+	"SB": "AA,KK,QQ,JJ,AKs,TT,AKo,AQs,99,AJs,KQs,88,ATs,AQo,KJs,QJs,77,KTs," +
+		"AJo,JTs,A9s,KQo,QTs,66,A8s,A5s,K9s,T9s,ATo,KJo,A7s,55,A4s,A6s,A3s," +
+		"Q9s,J9s,98s,QJo,44,A2s,KTo,K8s,87s,T8s,JTo,QTo,33,A9o,K7s,97s,76s," +
+		"J8s,22,K6s,A8o,Q8s,K5s,65s,86s,K9o,A7o,K4s,54s,Q9o,T9o,J9o,A5o",
+}
+
+// positionAliases maps other common position names to chart positions.
+var positionAliases = map[string]string{
+	"EP":  "UTG",
+	"MP":  "HJ",
+	"LJ":  "HJ",
+	"BU":  "BTN",
+	"BUT": "BTN",
+}
+
+// Positions returns the positions with an opening chart, in table order.
+func Positions() []string {
+	return []string{"UTG", "HJ", "CO", "BTN", "SB"}
+}
+
+// OpeningRange returns the range a player in position opens with when
+// raising percent of hands (0-100), taken from the top of the position's
+// built-in chart: the smallest prefix of the chart holding at least that
+// share of the 1326 starting combos. A percent of 0 returns the full
+// chart. Positions are case-insensitive and accept aliases such as "MP"
+// for HJ and "BU" for BTN.
+func OpeningRange(position string, percent float64) (Range, error) {
+	name := strings.ToUpper(strings.TrimSpace(position))
+	if alias, ok := positionAliases[name]; ok {
+		name = alias
+	}
+	chart, ok := openingCharts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown position %q: expected one of %s", position, strings.Join(Positions(), ", "))
+	}
+
+	hands := strings.Split(chart, ",")
+	full, err := Parse(chart)
+	if err != nil {
+		return nil, err
+	}
+	if percent == 0 {
+		return full, nil
+	}
+
+	widest := 100 * float64(len(full)) / totalCombos
+	if percent < 0 || percent > widest {
+		return nil, fmt.Errorf("%s opens between 0%% and %.1f%% of hands, got %g%%", name, widest, percent)
+	}
+
+	target := percent / 100 * totalCombos
+	combos := 0
+	for i, hand := range hands {
+		r, err := Parse(hand)
+		if err != nil {
+			return nil, err
+		}
+		combos += len(r)
+		if float64(combos) >= target {
+			return Parse(strings.Join(hands[:i+1], ","))
+		}
+	}
+	return full, nil
+}
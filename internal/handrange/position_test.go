@@ -0,0 +1,107 @@
+package handrange
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOpeningRange(t *testing.T) {
+	tests := []struct {
+		position string
+		full     int
+		// top is the 5% range, whose chart prefix ends at last.
+		top     string
+		last    string
+		topSize int
+	}{
+		{"UTG", 204, "AA AJs AKo AKs AQo AQs JJ KK KQs QQ TT", "AQo", 70},
+		{"HJ", 246, "AA AJs AKo AKs AQo AQs JJ KK KQs QQ TT", "AQo", 70},
+		{"CO", 334, "88 99 AA AJs AKo AKs AQs JJ KK KQs QQ TT", "88", 70},
+		{"BTN", 582, "88 99 AA AJs AKo AKs AQs JJ KK KQs QQ TT", "88", 70},
+		{"SB", 442, "88 99 AA AJs AKo AKs AQs JJ KK KQs QQ TT", "88", 70},
+	}
+	for _, tt := range tests {
+		t.Run(tt.position, func(t *testing.T) {
+			full, err := OpeningRange(tt.position, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(full) != tt.full {
+				t.Errorf("full chart has %d combos, want %d", len(full), tt.full)
+			}
+			chart, err := Parse(openingCharts[tt.position])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if classes(full) != classes(chart) {
+				t.Errorf("full range %q, want the whole chart %q", classes(full), classes(chart))
+			}
+
+			widest := 100 * float64(tt.full) / totalCombos
+			if r, err := OpeningRange(tt.position, widest); err != nil || len(r) != tt.full {
+				t.Errorf("OpeningRange(%s, %.2f) = %d combos, %v; want the full chart", tt.position, widest, len(r), err)
+			}
+
+			top, err := OpeningRange(tt.position, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(top) != tt.topSize || classes(top) != tt.top {
+				t.Errorf("5%% range = %d combos %q, want %d combos %q", len(top), classes(top), tt.topSize, tt.top)
+			}
+			// The cut is the shortest chart prefix reaching 5%, so it ends
+			// at last and dropping last falls short.
+			hands := strings.Split(openingCharts[tt.position], ",")
+			n := 0
+			for n < len(hands) && hands[n] != tt.last {
+				n++
+			}
+			shorter, err := Parse(strings.Join(hands[:n], ","))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n+1 != len(strings.Fields(tt.top)) || float64(len(shorter)) >= 0.05*totalCombos {
+				t.Errorf("5%% range does not end at %s", tt.last)
+			}
+		})
+	}
+}
+
+func TestOpeningRangeAliases(t *testing.T) {
+	for alias, position := range map[string]string{
+		"ep": "UTG", "MP": "HJ", "lj": "HJ", "BU": "BTN", "but": "BTN", " btn ": "BTN", "co": "CO",
+	} {
+		got, err := OpeningRange(alias, 10)
+		if err != nil {
+			t.Errorf("OpeningRange(%q): %v", alias, err)
+			continue
+		}
+		want, err := OpeningRange(position, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if classes(got) != classes(want) {
+			t.Errorf("OpeningRange(%q) = %q, want %s's %q", alias, classes(got), position, classes(want))
+		}
+	}
+}
+
+func TestOpeningRangeRejects(t *testing.T) {
+	tests := []struct {
+		position string
+		percent  float64
+		want     string
+	}{
+		{"BB", 0, "unknown position"},
+		{"", 10, "unknown position"},
+		{"UTG", -1, "opens between"},
+		{"UTG", 16, "opens between"},
+		{"BTN", 100, "opens between"},
+	}
+	for _, tt := range tests {
+		r, err := OpeningRange(tt.position, tt.percent)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("OpeningRange(%q, %g) = %d combos, %v; want an error containing %q", tt.position, tt.percent, len(r), err, tt.want)
+		}
+	}
+}
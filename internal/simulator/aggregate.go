@@ -0,0 +1,211 @@
+package simulator
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// atomicWorkerThreshold is the worker count above which workers add their
+// tallies to shared atomic counters instead of sending a result each over
+// a channel, so a calculation never holds a result per worker.
+const atomicWorkerThreshold = 64
+
+// mergeResults sums worker results and returns each worker's win rate.
+// The first worker error, if any, is returned in the merged result.
+func mergeResults(results []workerResult) (workerResult, []float64) {
+	merged := workerResult{winningHands: make(map[evaluator.HandRank]int)}
+	rates := make([]float64, 0, len(results))
+
+	for _, result := range results {
+		if result.err != nil {
+			return workerResult{err: result.err}, nil
+		}
+		merged.wins += result.wins
+		merged.ties += result.ties
+		merged.splitShares += result.splitShares
+		merged.splitSquares += result.splitSquares
+		merged.simulations += result.simulations
+		merged.showdowns += result.showdowns
+		for rank, count := range result.winningHands {
+			merged.winningHands[rank] += count
+		}
+		if merged.headToHead == nil {
+			merged.headToHead = make([]headToHeadCount, len(result.headToHead))
+		}
+		for i, count := range result.headToHead {
+			merged.headToHead[i].wins += count.wins
+			merged.headToHead[i].ties += count.ties
+		}
+		for key, count := range result.losingHands {
+			if merged.losingHands == nil {
+				merged.losingHands = make(map[holdingKey]int)
+			}
+			merged.losingHands[key] += count
+		}
+		if result.showdowns > 0 {
+			rates = append(rates, float64(result.wins)/float64(result.showdowns))
+		}
+	}
+	return merged, rates
+}
+
+// atomicTally accumulates worker results in shared counters.
+type atomicTally struct {
+	wins         atomic.Int64
+	ties         atomic.Int64
+	simulations  atomic.Int64
+	showdowns    atomic.Int64
+	winningHands [evaluator.RoyalFlush + 1]atomic.Int64
+	headToHead   []atomicHeadToHead
+
+	// splits holds each worker's split pot sums in its own slot. Floating
+	// point addition depends on order, so they are summed in worker order
+	// at the end, as mergeResults does, rather than as workers finish.
+	splits []splitSums
+
+	// losingHands is merged under mu; only some calculations track it.
+	mu          sync.Mutex
+	losingHands map[holdingKey]int
+
+	errOnce sync.Once
+	err     error
+}
+
+// splitSums is one worker's workerResult.splitShares and splitSquares.
+type splitSums struct {
+	shares, squares float64
+}
+
+// atomicHeadToHead counts the hero's wins and ties against one opponent.
+type atomicHeadToHead struct {
+	wins atomic.Int64
+	ties atomic.Int64
+}
+
+// add adds a worker's result to the tally.
+func (t *atomicTally) add(worker int, result workerResult) {
+	if result.err != nil {
+		t.errOnce.Do(func() { t.err = result.err })
+		return
+	}
+	t.wins.Add(int64(result.wins))
+	t.ties.Add(int64(result.ties))
+	t.simulations.Add(int64(result.simulations))
+	t.showdowns.Add(int64(result.showdowns))
+	for rank, count := range result.winningHands {
+		t.winningHands[rank].Add(int64(count))
+	}
+	for i, count := range result.headToHead {
+		t.headToHead[i].wins.Add(int64(count.wins))
+		t.headToHead[i].ties.Add(int64(count.ties))
+	}
+	t.splits[worker] = splitSums{shares: result.splitShares, squares: result.splitSquares}
+	t.mu.Lock()
+	if len(result.losingHands) > 0 && t.losingHands == nil {
+		t.losingHands = make(map[holdingKey]int)
+	}
+	for key, count := range result.losingHands {
+		t.losingHands[key] += count
+	}
+	t.mu.Unlock()
+}
+
+// result converts the tally to a merged worker result.
+func (t *atomicTally) result() workerResult {
+	if t.err != nil {
+		return workerResult{err: t.err}
+	}
+
+	merged := workerResult{
+		wins:         int(t.wins.Load()),
+		ties:         int(t.ties.Load()),
+		simulations:  int(t.simulations.Load()),
+		showdowns:    int(t.showdowns.Load()),
+		winningHands: make(map[evaluator.HandRank]int),
+		headToHead:   make([]headToHeadCount, len(t.headToHead)),
+		losingHands:  t.losingHands,
+	}
+	for _, split := range t.splits {
+		merged.splitShares += split.shares
+		merged.splitSquares += split.squares
+	}
+	for rank := range t.winningHands {
+		if count := t.winningHands[rank].Load(); count > 0 {
+			merged.winningHands[evaluator.HandRank(rank)] = int(count)
+		}
+	}
+	for i := range t.headToHead {
+		merged.headToHead[i] = headToHeadCount{
+			wins: int(t.headToHead[i].wins.Load()),
+			ties: int(t.headToHead[i].ties.Load()),
+		}
+	}
+	return merged
+}
+
+// runAtomic runs each worker's share on its own goroutine, adding results
+// to shared atomic counters instead of collecting them over a channel.
+// Each worker records only its win rate and split pot sums, in its own
+// slot.
+func (e *Engine) runAtomic(ctx context.Context, params OddsParams, shares []int, first int) (workerResult, []float64) {
+	tally := &atomicTally{
+		headToHead: make([]atomicHeadToHead, len(params.OpponentHoleCards)),
+		splits:     make([]splitSums, len(shares)),
+	}
+	rates := make([]float64, len(shares))
+	offsets := shareOffsets(shares, first)
+
+	var wg sync.WaitGroup
+	for i, sims := range shares {
+		wg.Add(1)
+
+		rng := e.workerRand(params.Seed, offsets[i])
+		go func(i, sims int) {
+			defer wg.Done()
+			e.acquireWorker()
+			defer e.releaseWorker()
+			deck := e.decks.get(params.knownCards())
+			result := runSimulations(ctx, params, deck, sims, rng, nil)
+			tally.add(i, result)
+			rates[i] = -1
+			if result.showdowns > 0 {
+				rates[i] = float64(result.wins) / float64(result.showdowns)
+			}
+		}(i, sims)
+	}
+	wg.Wait()
+
+	observed := rates[:0]
+	for _, rate := range rates {
+		if rate >= 0 {
+			observed = append(observed, rate)
+		}
+	}
+	return tally.result(), observed
+}
+
+// winRateSpread returns the sample variance and standard deviation of
+// worker win rates. Both are zero with fewer than two rates.
+func winRateSpread(rates []float64) (float64, float64) {
+	if len(rates) < 2 {
+		return 0, 0
+	}
+
+	mean := 0.0
+	for _, rate := range rates {
+		mean += rate
+	}
+	mean /= float64(len(rates))
+
+	variance := 0.0
+	for _, rate := range rates {
+		variance += (rate - mean) * (rate - mean)
+	}
+	variance /= float64(len(rates) - 1)
+
+	return variance, math.Sqrt(variance)
+}
@@ -0,0 +1,63 @@
+package simulator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// splitParams deals four random opponents to a board where a ten makes
+// broadway, so many pots split two or more ways.
+func splitParams(t testing.TB) OddsParams {
+	return OddsParams{
+		HoleCards:    mustCards(t, "Tc 3d"),
+		BoardCards:   mustCards(t, "Ah Kd Qc Js"),
+		NumOpponents: 4,
+		Seed:         seed(7),
+	}
+}
+
+// TestAtomicMatchesParallel checks that the shared-counter path gives
+// exactly the tallies of the channel path, split pot sums included,
+// however its workers finish.
+func TestAtomicMatchesParallel(t *testing.T) {
+	engine := NewEngine()
+	params := splitParams(t)
+	shares := splitSimulations(2000, 2*atomicWorkerThreshold)
+
+	parallel, parallelRates := mergeResults(engine.runParallel(context.Background(), params, shares, 0))
+	if parallel.ties == 0 {
+		t.Fatal("no split pots to compare")
+	}
+	for run := 0; run < 5; run++ {
+		atomic, atomicRates := engine.runAtomic(context.Background(), params, shares, 0)
+		if !reflect.DeepEqual(atomic, parallel) {
+			t.Fatalf("run %d: atomic %+v, parallel %+v", run, atomic, parallel)
+		}
+		if !reflect.DeepEqual(atomicRates, parallelRates) {
+			t.Fatalf("run %d: atomic rates differ from parallel rates", run)
+		}
+	}
+}
+
+// benchmarkManyWorkers measures a calculation split across many workers
+// with a few simulations each, tallied by the given path.
+func benchmarkManyWorkers(b *testing.B, run func(*Engine, context.Context, OddsParams, []int, int) (workerResult, []float64)) {
+	engine := NewEngine()
+	params := splitParams(b)
+	shares := splitSimulations(4*256, 256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		run(engine, context.Background(), params, shares, 0)
+	}
+}
+
+func BenchmarkManyWorkersAtomic(b *testing.B) {
+	benchmarkManyWorkers(b, (*Engine).runAtomic)
+}
+
+func BenchmarkManyWorkersParallel(b *testing.B) {
+	benchmarkManyWorkers(b, func(e *Engine, ctx context.Context, params OddsParams, shares []int, first int) (workerResult, []float64) {
+		return mergeResults(e.runParallel(ctx, params, shares, first))
+	})
+}
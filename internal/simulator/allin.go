@@ -0,0 +1,231 @@
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// AllInPlayer is one player in an all-in showdown.
+type AllInPlayer struct {
+	HoleCards []*card.Card
+	// Committed is the number of chips the player has put in the pot.
+	Committed int
+}
+
+// AllInParams describes an all-in showdown between known hands.
+type AllInParams struct {
+	Players    []AllInPlayer
+	BoardCards []*card.Card
+	DeadCards  []*card.Card
+	// Simulations and Workers fall back to the engine defaults when below 1.
+	Simulations int
+	Workers     int
+	// Seed makes the calculation reproducible when set.
+	Seed *int64
+}
+
+// Pot is the main pot or a side pot and the players who can win it.
+type Pot struct {
+	Amount int `json:"amount"`
+	// Eligible holds indexes into AllInParams.Players.
+	Eligible []int `json:"eligible"`
+}
+
+// PlayerEquity is one player's expected result from an all-in showdown.
+type PlayerEquity struct {
+	// ExpectedChips is the average number of chips the player wins.
+	ExpectedChips float64 `json:"expected_chips"`
+	// Equity is ExpectedChips as a share of every pot combined.
+	Equity float64 `json:"equity"`
+}
+
+// AllInResult contains the pot structure and each player's chip EV.
+type AllInResult struct {
+	Pots    []Pot          `json:"pots"`
+	Players []PlayerEquity `json:"players"`
+}
+
+// AllInEquity simulates an all-in showdown with unequal stacks. Chips are
+// split into a main pot and side pots by commitment, and each pot goes to
+// the best hand among the players who covered it.
+func (e *Engine) AllInEquity(params AllInParams) (*AllInResult, error) {
+	if err := checkAllInPlayers(params); err != nil {
+		return nil, err
+	}
+	if err := checkAllInDeck(params); err != nil {
+		return nil, err
+	}
+
+	simulations, workers, err := e.simulationCounts(params.Simulations, params.Workers)
+	if err != nil {
+		return nil, err
+	}
+
+	committed := make([]int, len(params.Players))
+	for i, player := range params.Players {
+		committed[i] = player.Committed
+	}
+	pots := buildPots(committed)
+
+	known := params.knownCards()
+	shares := splitSimulations(simulations, workers)
+	chips := make([][]float64, len(shares))
+	offsets := shareOffsets(shares, 0)
+
+	var wg sync.WaitGroup
+	for i, sims := range shares {
+		wg.Add(1)
+
+		rng := e.workerRand(params.Seed, offsets[i])
+		go func(i, sims int) {
+			defer wg.Done()
+			e.acquireWorker()
+			defer e.releaseWorker()
+			deck := e.decks.get(known)
+			chips[i] = runAllIn(params, pots, deck, sims, rng)
+		}(i, sims)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, pot := range pots {
+		total += pot.Amount
+	}
+
+	players := make([]PlayerEquity, len(params.Players))
+	for _, workerChips := range chips {
+		for p, won := range workerChips {
+			players[p].ExpectedChips += won
+		}
+	}
+	for p := range players {
+		players[p].ExpectedChips /= float64(simulations)
+		players[p].Equity = players[p].ExpectedChips / float64(total)
+	}
+
+	return &AllInResult{Pots: pots, Players: players}, nil
+}
+
+// buildPots splits committed chips into a main pot and side pots. Each
+// commitment level forms a pot contested by the players who reached it.
+func buildPots(committed []int) []Pot {
+	levels := make([]int, 0, len(committed))
+	for _, amount := range committed {
+		levels = append(levels, amount)
+	}
+	sort.Ints(levels)
+
+	var pots []Pot
+	previous := 0
+	for _, level := range levels {
+		if level == previous {
+			continue
+		}
+
+		pot := Pot{}
+		for p, amount := range committed {
+			if amount > previous {
+				pot.Amount += min(amount, level) - previous
+			}
+			if amount >= level {
+				pot.Eligible = append(pot.Eligible, p)
+			}
+		}
+		pots = append(pots, pot)
+		previous = level
+	}
+	return pots
+}
+
+// runAllIn performs all-in simulations for one worker and returns the
+// chips each player won in total.
+func runAllIn(params AllInParams, pots []Pot, deck []uint8, simulations int, streams *simulationRand) []float64 {
+	won := make([]float64, len(params.Players))
+	results := make([]*evaluator.HandResult, len(params.Players))
+	hand := make([]*card.Card, 0, 7)
+	base := streams.baseDeck(deck)
+
+	for i := 0; i < simulations; i++ {
+		rng, reset := streams.advance()
+		if reset {
+			copy(deck, base)
+		}
+		shuffleDeck(deck, rng)
+
+		board := make([]*card.Card, len(params.BoardCards), 5)
+		copy(board, params.BoardCards)
+		for next := 0; len(board) < 5; next++ {
+			board = append(board, deckCards[deck[next]])
+		}
+
+		for p, player := range params.Players {
+			hand = append(append(hand[:0], player.HoleCards...), board...)
+			results[p] = evaluator.EvaluateHand(hand)
+		}
+
+		for _, pot := range pots {
+			var winners []int
+			for _, p := range pot.Eligible {
+				if len(winners) == 0 {
+					winners = append(winners, p)
+					continue
+				}
+				switch results[p].Compare(results[winners[0]]) {
+				case 1:
+					winners = append(winners[:0], p)
+				case 0:
+					winners = append(winners, p)
+				}
+			}
+
+			share := float64(pot.Amount) / float64(len(winners))
+			for _, p := range winners {
+				won[p] += share
+			}
+		}
+	}
+	return won
+}
+
+// checkAllInPlayers verifies every player has two hole cards and chips in
+// the pot.
+func checkAllInPlayers(params AllInParams) error {
+	if len(params.Players) < 2 {
+		return newDealError(ErrAllInPlayers, "an all-in showdown needs at least 2 players")
+	}
+	for i, player := range params.Players {
+		if len(player.HoleCards) != 2 {
+			return newDealError(ErrAllInPlayers, fmt.Sprintf(
+				"player %d must have exactly 2 hole cards, got %d", i+1, len(player.HoleCards)))
+		}
+		if player.Committed < 1 {
+			return newDealError(ErrAllInPlayers, fmt.Sprintf(
+				"player %d must commit at least 1 chip", i+1))
+		}
+	}
+	return nil
+}
+
+// checkAllInDeck verifies that enough cards remain to complete the board
+// once every player's hand and the dead cards are removed.
+func checkAllInDeck(params AllInParams) error {
+	deck := card.RemoveCards(card.NewDeck(), params.knownCards())
+	if needed := 5 - len(params.BoardCards); len(deck) < needed {
+		return newDealError(ErrInsufficientCards, fmt.Sprintf(
+			"completing the board requires %d cards but only %d remain", needed, len(deck)))
+	}
+	return nil
+}
+
+// knownCards returns every card that cannot be dealt during simulation.
+func (p AllInParams) knownCards() []*card.Card {
+	known := append(append([]*card.Card{}, p.BoardCards...), p.DeadCards...)
+	for _, player := range p.Players {
+		known = append(known, player.HoleCards...)
+	}
+	return known
+}
@@ -0,0 +1,41 @@
+package simulator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// TestAllInDeckSize deals ten players and fills the deck with dead cards
+// until exactly a board remains, then one card fewer.
+func TestAllInDeckSize(t *testing.T) {
+	deck := card.NewDeck()
+	players := make([]AllInPlayer, 10)
+	for i := range players {
+		players[i] = AllInPlayer{HoleCards: deck[2*i : 2*i+2], Committed: 100}
+	}
+	rest := deck[2*len(players):]
+
+	// 32 cards remain; the board needs 5 of them.
+	params := AllInParams{Players: players, DeadCards: rest[:27], Simulations: 10, Workers: 2}
+	if _, err := NewEngine().AllInEquity(params); err != nil {
+		t.Fatalf("5 cards left for the board: %v", err)
+	}
+
+	params.DeadCards = rest[:30]
+	_, err := NewEngine().AllInEquity(params)
+	if !errors.Is(err, ErrInsufficientCards) {
+		t.Fatalf("2 cards left for the board: err = %v, want ErrInsufficientCards", err)
+	}
+	const want = "completing the board requires 5 cards but only 2 remain"
+	if err.Error() != want {
+		t.Errorf("err = %q, want %q", err, want)
+	}
+
+	params.BoardCards = rest[30:32]
+	params.DeadCards = rest[:30]
+	if _, err := NewEngine().AllInEquity(params); !errors.Is(err, ErrInsufficientCards) {
+		t.Errorf("no cards left for a 2-card board: err = %v, want ErrInsufficientCards", err)
+	}
+}
@@ -0,0 +1,68 @@
+package simulator
+
+import "math/big"
+
+// CombinationCount is the size of an exact enumeration of a calculation:
+// every way to complete the boards and deal the unknown hole cards.
+type CombinationCount struct {
+	// Runouts is the number of ways to complete every board.
+	Runouts *big.Int `json:"runouts"`
+	// OpponentDeals is the number of ways to deal the unknown hole cards
+	// of opponents and folded players for each runout. It is 1 when every
+	// opponent's hand is known.
+	OpponentDeals *big.Int `json:"opponent_deals"`
+	// Combinations is Runouts times OpponentDeals.
+	Combinations *big.Int `json:"combinations"`
+	// Enumerable reports whether ExactOdds supports the calculation, which
+	// needs every hand known.
+	Enumerable bool `json:"enumerable"`
+	// WithinLimit reports whether Combinations is within MaxRunouts.
+	WithinLimit bool `json:"within_limit"`
+}
+
+// CountCombinations returns how many combinations an exact enumeration of
+// params would evaluate, without evaluating any. The count is before suit
+// symmetry, which lets ExactOdds evaluate fewer classes. Ranges are not
+// supported, since combos shared between players make their deals hard to
+// count.
+func (e *Engine) CountCombinations(params OddsParams) (*CombinationCount, error) {
+	if err := checkCards(params); err != nil {
+		return nil, err
+	}
+	if err := checkDeckSize(params); err != nil {
+		return nil, err
+	}
+	if params.OpponentRange != nil || params.HeroRange != nil {
+		return nil, newDealError(ErrNotEnumerable, "combination counts do not support ranges")
+	}
+
+	left := len(deckCards) - len(params.knownCards())
+	runouts := big.NewInt(1)
+	for b := 0; b < params.boards(); b++ {
+		missing := 5 - len(params.BoardCards)
+		runouts.Mul(runouts, new(big.Int).Binomial(int64(left), int64(missing)))
+		left -= missing
+	}
+
+	deals := big.NewInt(1)
+	for _, hand := range params.OpponentHoleCards {
+		if len(hand) == 1 {
+			deals.Mul(deals, big.NewInt(int64(left)))
+			left--
+		}
+	}
+	holeSize := params.holeSize()
+	for i := len(params.OpponentHoleCards); i < params.NumOpponents+params.FoldedPlayers; i++ {
+		deals.Mul(deals, new(big.Int).Binomial(int64(left), int64(holeSize)))
+		left -= holeSize
+	}
+
+	combinations := new(big.Int).Mul(runouts, deals)
+	return &CombinationCount{
+		Runouts:       runouts,
+		OpponentDeals: deals,
+		Combinations:  combinations,
+		Enumerable:    checkEnumerable(params) == nil,
+		WithinLimit:   e.MaxRunouts <= 0 || combinations.Cmp(big.NewInt(int64(e.MaxRunouts))) <= 0,
+	}, nil
+}
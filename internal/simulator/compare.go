@@ -0,0 +1,85 @@
+package simulator
+
+import "math"
+
+// tieZScore is the z-score of the 95% confidence interval within which two
+// equities are reported as statistically tied.
+const tieZScore = 1.96
+
+// EquityComparison compares the equity of two calculations, or of two
+// hands in one RankHands calculation.
+type EquityComparison struct {
+	// Difference is the first result's pot share minus the second's.
+	Difference float64 `json:"difference"`
+	// StandardError is the standard error of Difference.
+	StandardError float64 `json:"standard_error"`
+	// Margin is the half-width of the 95% confidence interval.
+	Margin float64 `json:"margin"`
+	// StatisticallyTied is set when Difference lies within the 95%
+	// confidence interval around zero.
+	StatisticallyTied bool `json:"statistically_tied"`
+}
+
+// CompareEquity compares the pot share of two calculations, treating a
+// difference smaller than its confidence interval as a tie.
+func CompareEquity(a, b *OddsResult) EquityComparison {
+	standardError := math.Hypot(a.PotShareStandardError(), b.PotShareStandardError())
+	return newEquityComparison(a.PotShare-b.PotShare, standardError)
+}
+
+// newEquityComparison reports a difference in pot share with its 95%
+// confidence interval.
+func newEquityComparison(difference, standardError float64) EquityComparison {
+	return EquityComparison{
+		Difference:        difference,
+		StandardError:     standardError,
+		Margin:            tieZScore * standardError,
+		StatisticallyTied: math.Abs(difference) <= tieZScore*standardError,
+	}
+}
+
+// PotShareStandardError returns the standard error of PotShare, treating
+// each showdown as a sample worth 1 for a win, 1/k for a k-way tie, and 0
+// for a loss. With fold equity only the showdowns are sampled, so it is
+// scaled by the chance of being called.
+func (r *OddsResult) PotShareStandardError() float64 {
+	if r.Showdowns == 0 {
+		return 0
+	}
+	return math.Sqrt(r.potShareVariance() / float64(r.Showdowns))
+}
+
+// SuggestedSimulations returns the total simulations the calculation would
+// need for the 95% confidence interval of PotShare to be within ±margin,
+// given the variance observed so far. Compared with Simulations, it shows
+// whether a rerun is worth it. It returns 0 for exact results, which have
+// no sampling error, and for a margin that is not positive.
+func (r *OddsResult) SuggestedSimulations(margin float64) int {
+	if r.Classes > 0 || r.Simulations == 0 || margin <= 0 {
+		return 0
+	}
+	boards := r.Showdowns / r.Simulations
+	return simulationsForMargin(r.potShareVariance(), margin, boards)
+}
+
+// potShareVariance returns the variance of a single showdown's share of
+// the pot, scaled for fold equity as in PotShareStandardError.
+func (r *OddsResult) potShareVariance() float64 {
+	share, called := r.PotShare, 1.0
+	if r.FoldEquity > 0 {
+		share, called = r.ShowdownPotShare, 1-r.FoldEquity
+	}
+	variance := r.shareSquares - share*share
+	if variance < 0 {
+		variance = 0
+	}
+	return called * called * variance
+}
+
+// simulationsForMargin returns the simulations, each dealing boards
+// showdowns, for the 95% confidence interval of a mean of showdowns with
+// the given variance to be within ±margin.
+func simulationsForMargin(variance, margin float64, boards int) int {
+	needed := tieZScore * tieZScore * variance / (margin * margin)
+	return int(math.Ceil(needed / float64(boards)))
+}
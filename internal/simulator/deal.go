@@ -0,0 +1,125 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// maxDealPlayers is the most players Deal seats at one table.
+const maxDealPlayers = 10
+
+// DealParams describes one random hand for Deal.
+type DealParams struct {
+	// Players is the number of seats dealt in, 2-10.
+	Players int
+	// HoleCards fixes the hole cards of the first players. An entry may
+	// hold 0-2 cards; missing cards are dealt at random.
+	HoleCards [][]*card.Card
+	// BoardCards fixes the first board cards; the rest are dealt.
+	BoardCards []*card.Card
+	// Seed makes the deal reproducible when set.
+	Seed *int64
+}
+
+// DealtPlayer is one player's cards and best hand in a dealt hand.
+type DealtPlayer struct {
+	HoleCards []*card.Card
+	Hand      *evaluator.HandResult
+}
+
+// DealResult is a complete hand: every player's hole cards, the full
+// board, and who won the showdown.
+type DealResult struct {
+	Players    []DealtPlayer
+	BoardCards []*card.Card
+	// Winners holds the indexes of the players with the best hand, more
+	// than one when they split the pot.
+	Winners []int
+}
+
+// Deal deals a complete random hand from a shuffled deck and shows it
+// down. Fixed cards are removed from the deck first; the missing hole
+// cards are then dealt seat by seat, followed by the rest of the board.
+func (e *Engine) Deal(params DealParams) (*DealResult, error) {
+	if err := checkDealCards(params); err != nil {
+		return nil, err
+	}
+
+	known := append([]*card.Card{}, params.BoardCards...)
+	for _, hand := range params.HoleCards {
+		known = append(known, hand...)
+	}
+	deck := card.RemoveCards(card.NewDeck(), known)
+	rng, _ := e.workerRand(params.Seed, 0).advance()
+	card.Shuffle(deck, rng)
+
+	next := 0
+	draw := func() *card.Card {
+		next++
+		return deck[next-1]
+	}
+
+	result := &DealResult{Players: make([]DealtPlayer, params.Players)}
+	for i := range result.Players {
+		hole := make([]*card.Card, 0, 2)
+		if i < len(params.HoleCards) {
+			hole = append(hole, params.HoleCards[i]...)
+		}
+		for len(hole) < 2 {
+			hole = append(hole, draw())
+		}
+		result.Players[i].HoleCards = hole
+	}
+
+	result.BoardCards = append(make([]*card.Card, 0, 5), params.BoardCards...)
+	for len(result.BoardCards) < 5 {
+		result.BoardCards = append(result.BoardCards, draw())
+	}
+
+	var best *evaluator.HandResult
+	for i := range result.Players {
+		player := &result.Players[i]
+		player.Hand = evaluator.EvaluateWithBoard(player.HoleCards, result.BoardCards)
+
+		comparison := 1
+		if best != nil {
+			comparison = player.Hand.Compare(best)
+		}
+		if comparison > 0 {
+			best = player.Hand
+			result.Winners = result.Winners[:0]
+		}
+		if comparison >= 0 {
+			result.Winners = append(result.Winners, i)
+		}
+	}
+	return result, nil
+}
+
+// checkDealCards verifies the seat count, that no player is given more
+// than two hole cards or the board more than five, and that no fixed card
+// appears twice.
+func checkDealCards(params DealParams) error {
+	switch {
+	case params.Players < 2 || params.Players > maxDealPlayers:
+		return newDealError(ErrOpponentHands, fmt.Sprintf(
+			"a deal needs 2-%d players, got %d", maxDealPlayers, params.Players))
+	case len(params.HoleCards) > params.Players:
+		return newDealError(ErrOpponentHands, fmt.Sprintf(
+			"given %d hands for %d players", len(params.HoleCards), params.Players))
+	case len(params.BoardCards) > 5:
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"board cannot have more than 5 cards, got %d", len(params.BoardCards)))
+	}
+	known := append([]*card.Card{}, params.BoardCards...)
+	for i, hand := range params.HoleCards {
+		if len(hand) > 2 {
+			return newDealError(ErrOpponentHands, fmt.Sprintf(
+				"player %d cannot have more than 2 hole cards, got %d", i+1, len(hand)))
+		}
+		known = append(known, hand...)
+	}
+	return card.CheckUnique(known)
+}
@@ -0,0 +1,56 @@
+package simulator
+
+import "math"
+
+// Actions recommended in Decision.Action.
+const (
+	ActionCall = "call"
+	ActionFold = "fold"
+)
+
+// Decision weighs the hero's equity against the price of calling a bet.
+type Decision struct {
+	// RequiredEquity is the pot share at which calling breaks even.
+	RequiredEquity float64 `json:"required_equity"`
+	// Equity is the hero's pot share.
+	Equity float64 `json:"equity"`
+	// EquityMargin is Equity minus RequiredEquity.
+	EquityMargin float64 `json:"equity_margin"`
+	// CallEV is the expected chips gained by calling instead of folding,
+	// in the units of the pot and bet.
+	CallEV float64 `json:"call_ev"`
+	// Action is ActionCall when Equity reaches RequiredEquity, and
+	// ActionFold otherwise.
+	Action string `json:"action"`
+	// Close is set when EquityMargin is within the 95% confidence interval
+	// of a simulated Equity, so a longer run could reverse Action.
+	Close bool `json:"close"`
+}
+
+// RequiredEquity returns the equity needed to call bet into pot, where
+// pot is the pot before the bet: the call risks bet to win pot+bet, so it
+// breaks even at bet/(pot+2*bet).
+func RequiredEquity(pot, bet float64) float64 {
+	return bet / (pot + 2*bet)
+}
+
+// Decide recommends calling or folding a bet of bet into pot, given the
+// hero's odds in the hand.
+func Decide(result *OddsResult, pot, bet float64) Decision {
+	required := RequiredEquity(pot, bet)
+	margin := result.PotShare - required
+
+	decision := Decision{
+		RequiredEquity: required,
+		Equity:         result.PotShare,
+		EquityMargin:   margin,
+		CallEV:         result.PotShare*(pot+2*bet) - bet,
+		Action:         ActionFold,
+		// Exact odds have no sampling error.
+		Close: result.Classes == 0 && math.Abs(margin) <= tieZScore*result.PotShareStandardError(),
+	}
+	if margin >= 0 {
+		decision.Action = ActionCall
+	}
+	return decision
+}
@@ -0,0 +1,55 @@
+package simulator
+
+import (
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// maxPooledDecks bounds how many distinct known-card sets a pool keeps.
+const maxPooledDecks = 4096
+
+// deckPool caches prepared base decks keyed by the set of known cards, so
+// repeated calculations of the same spot skip rebuilding and filtering the
+// deck. It is safe for concurrent use; callers receive their own copy.
+type deckPool struct {
+	mu    sync.RWMutex
+	decks map[uint64][]uint8
+}
+
+// newDeckPool creates an empty deck pool.
+func newDeckPool() *deckPool {
+	return &deckPool{decks: make(map[uint64][]uint8)}
+}
+
+// get returns a fresh copy of the base deck without the known cards.
+// A nil pool builds the deck without caching.
+func (p *deckPool) get(known []*card.Card) []uint8 {
+	if p == nil {
+		return newIndexDeck(known)
+	}
+
+	var key uint64
+	for _, c := range known {
+		key |= 1 << deckIndex(c)
+	}
+
+	p.mu.RLock()
+	base, ok := p.decks[key]
+	p.mu.RUnlock()
+
+	if !ok {
+		base = newIndexDeck(known)
+
+		p.mu.Lock()
+		if len(p.decks) >= maxPooledDecks {
+			p.decks = make(map[uint64][]uint8)
+		}
+		p.decks[key] = base
+		p.mu.Unlock()
+	}
+
+	deck := make([]uint8, len(base))
+	copy(deck, base)
+	return deck
+}
@@ -0,0 +1,147 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// drawHandSize is the number of cards each five-card draw player holds.
+const drawHandSize = 5
+
+// DrawParams describes a five-card draw showdown. There is no board: the
+// hero and every opponent hold five cards each.
+type DrawParams struct {
+	// HoleCards is the hero's five-card hand.
+	HoleCards []*card.Card
+	// Discards lists the positions in HoleCards, 0-4, the hero throws away
+	// and replaces from the deck before showdown. Empty stands pat.
+	// Discarded cards are out of play.
+	Discards []int
+	// NumOpponents are dealt five random cards each and stand pat.
+	NumOpponents int
+	DeadCards    []*card.Card
+	// Simulations and Workers fall back to the engine defaults when below 1.
+	Simulations int
+	Workers     int
+	// Seed makes the calculation reproducible when set.
+	Seed *int64
+}
+
+// DrawOdds simulates a five-card draw showdown of the hero's hand, after
+// the optional draw, against random five-card hands. HeadToHead and
+// TopLosingHands are not reported.
+func (e *Engine) DrawOdds(params DrawParams) (*OddsResult, error) {
+	if err := checkDrawParams(params); err != nil {
+		return nil, err
+	}
+
+	simulations, workers, err := e.simulationCounts(params.Simulations, params.Workers)
+	if err != nil {
+		return nil, err
+	}
+	if workers > simulations {
+		workers = simulations
+	}
+
+	known := append(append([]*card.Card{}, params.HoleCards...), params.DeadCards...)
+	shares := splitSimulations(simulations, workers)
+	results := make([]workerResult, len(shares))
+	offsets := shareOffsets(shares, 0)
+
+	var wg sync.WaitGroup
+	for i, sims := range shares {
+		wg.Add(1)
+
+		rng := e.workerRand(params.Seed, offsets[i])
+		go func(i, sims int) {
+			defer wg.Done()
+			e.acquireWorker()
+			defer e.releaseWorker()
+			deck := e.decks.get(known)
+			results[i] = runDraw(params, deck, sims, rng)
+		}(i, sims)
+	}
+	wg.Wait()
+
+	merged, rates := mergeResults(results)
+	result := newOddsResult(OddsParams{HoleCards: params.HoleCards, DeadCards: params.DeadCards}, merged)
+	result.StandardError = math.Sqrt(result.Win * (1 - result.Win) / float64(merged.showdowns))
+	result.WorkerWinVariance, result.WorkerWinStdDev = winRateSpread(rates)
+	return result, nil
+}
+
+// runDraw performs five-card draw simulations for one worker. The hero's
+// replacement cards come off the deck first, then each opponent's hand.
+func runDraw(params DrawParams, deck []uint8, simulations int, streams *simulationRand) workerResult {
+	result := newWorkerResult(OddsParams{})
+	base := streams.baseDeck(deck)
+
+	hero := make([]*card.Card, drawHandSize)
+	opponents := make([][]*card.Card, params.NumOpponents)
+	for j := range opponents {
+		opponents[j] = make([]*card.Card, drawHandSize)
+	}
+
+	for i := 0; i < simulations; i++ {
+		rng, reset := streams.advance()
+		if reset {
+			copy(deck, base)
+		}
+		shuffleDeck(deck, rng)
+
+		next := 0
+		copy(hero, params.HoleCards)
+		for _, position := range params.Discards {
+			hero[position] = deckCards[deck[next]]
+			next++
+		}
+		for _, hand := range opponents {
+			for k := range hand {
+				hand[k] = deckCards[deck[next]]
+				next++
+			}
+		}
+
+		result.addShowdown(hero, opponents, nil, 1)
+	}
+
+	result.simulations = simulations
+	return result
+}
+
+// checkDrawParams verifies the hero's hand and discards and that the deck
+// can replace the discards and deal every opponent.
+func checkDrawParams(params DrawParams) error {
+	if len(params.HoleCards) != drawHandSize {
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"five-card draw needs exactly %d hole cards, got %d", drawHandSize, len(params.HoleCards)))
+	}
+
+	var discarded [drawHandSize]bool
+	for _, position := range params.Discards {
+		if position < 0 || position >= drawHandSize || discarded[position] {
+			return newDealError(ErrInvalidCards, fmt.Sprintf(
+				"discard positions must be distinct and within 0-%d, got %v", drawHandSize-1, params.Discards))
+		}
+		discarded[position] = true
+	}
+
+	known := append(append([]*card.Card{}, params.HoleCards...), params.DeadCards...)
+	if err := card.CheckUnique(known); err != nil {
+		return err
+	}
+
+	if params.NumOpponents < 1 {
+		return newDealError(ErrOpponentHands, "five-card draw needs at least 1 opponent")
+	}
+	needed := drawHandSize*params.NumOpponents + len(params.Discards)
+	if remaining := len(deckCards) - len(known); needed > remaining {
+		return newDealError(ErrInsufficientCards, fmt.Sprintf(
+			"requested %d opponents and %d discards requires %d cards but only %d remain",
+			params.NumOpponents, len(params.Discards), needed, remaining))
+	}
+	return nil
+}
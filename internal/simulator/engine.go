@@ -0,0 +1,583 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// Engine evaluates hands and calculates odds using a shared configuration.
+// Construct one with NewEngine and reuse it; it is safe for concurrent use.
+type Engine struct {
+	// DefaultSimulations is used when a calculation requests no simulations.
+	DefaultSimulations int
+	// DefaultWorkers is used when a calculation requests no workers.
+	// NewEngine sets it to EffectiveCPUs.
+	DefaultWorkers int
+	// MaxParallel, when positive, bounds the worker goroutines simulating
+	// at once across every calculation on the engine; the rest wait their
+	// turn. It must be set before the engine is first used.
+	MaxParallel int
+	// NewRand creates the random source for each worker goroutine.
+	NewRand func() *rand.Rand
+	// MaxRunouts caps the board completions ExactOdds may enumerate.
+	MaxRunouts int
+
+	decks     *deckPool
+	results   *resultCache
+	slots     chan struct{}
+	slotsOnce sync.Once
+}
+
+// NewEngine creates an Engine with the standard defaults.
+func NewEngine() *Engine {
+	return &Engine{
+		DefaultSimulations: 10000,
+		DefaultWorkers:     EffectiveCPUs(),
+		MaxRunouts:         1000000,
+		NewRand: func() *rand.Rand {
+			return rand.New(rand.NewSource(time.Now().UnixNano()))
+		},
+		decks:   newDeckPool(),
+		results: newResultCache(),
+	}
+}
+
+// OddsParams describes a single odds calculation.
+type OddsParams struct {
+	HoleCards    []*card.Card
+	BoardCards   []*card.Card
+	NumOpponents int
+	// OpponentHoleCards fixes the hole cards of the first opponents. A hand
+	// with one known card is completed from the deck in every simulation.
+	// The remaining opponents are dealt from OpponentRange or at random.
+	OpponentHoleCards [][]*card.Card
+	// FoldedPlayers are dealt two cards each that are removed from play
+	// without competing at showdown.
+	FoldedPlayers int
+	// OpponentRange, when set, is the range every opponent is dealt from
+	// instead of a random holding.
+	OpponentRange handrange.Range
+	// OpponentFilter, when set, drops the OpponentRange combos that
+	// fail it on BoardCards before any are sampled. It needs at least
+	// three board cards.
+	OpponentFilter *RangeFilter
+	// HeroRange, when set, deals the hero a combo from the range in each
+	// simulation instead of HoleCards, which must then be empty.
+	HeroRange handrange.Range
+	// WeightedRange samples range combos in proportion to their weights,
+	// producing range-weighted equity; otherwise combos are equally likely.
+	WeightedRange bool
+	// Boards is the number of community boards dealt from the same deck,
+	// each deciding an equal part of the pot. Values below 2 deal a single
+	// board; multiple boards require BoardCards to be empty.
+	Boards int
+	// DeadCards are removed from the deck but belong to no player.
+	DeadCards []*card.Card
+	// BurnedCards are removed from the deck like DeadCards but reported
+	// separately, matching the burns of a live deal.
+	BurnedCards []*card.Card
+	// KnownOutOfPlay are cards seen leaving play however they went, such
+	// as folded hands shown, cards exposed by accident, or burns, for
+	// callers that do not track them apart. They are removed from the deck
+	// with DeadCards and BurnedCards.
+	KnownOutOfPlay []*card.Card
+	// TopLosingHands, when positive, reports that many of the specific
+	// opponent holdings that most often beat the hero.
+	TopLosingHands int
+	// Simulations and Workers fall back to the engine defaults when below 1.
+	// Workers is capped at Simulations so no worker runs idle.
+	Simulations int
+	Workers     int
+	// TargetMargin, when positive, chooses the simulation count: a pilot
+	// run estimates the variance of the hero's pot share, then enough
+	// simulations are added for its 95% confidence interval to be within
+	// ±TargetMargin. Simulations becomes the upper limit.
+	TargetMargin float64
+	// ChunkSize caps the simulations a TargetMargin calculation runs after
+	// the pilot before estimating the target again, defaulting to
+	// defaultChunkSize when below 1. Smaller chunks stop closer to the
+	// target at the cost of more merging.
+	ChunkSize int
+	// Seed makes the calculation reproducible when set.
+	Seed *int64
+	// HoleUse, when positive, plays an Omaha-style game: every player holds
+	// as many hole cards as HoleCards, and makes the best hand using
+	// exactly HoleUse of them and 5-HoleUse board cards, so 2 for Omaha.
+	// Opponent hands must be given in full or dealt at random; ranges and
+	// TopLosingHands are not supported. Together with board cards exposed
+	// before the deal this models other variants: Courchevel is five hole
+	// cards, HoleUse 2 and one BoardCards card.
+	HoleUse int
+	// IgnoreKickers decides showdowns by hand category alone, so hands of
+	// the same category split the pot whatever their kickers.
+	IgnoreKickers bool
+	// Perspective, when positive, reports the results from the seat of
+	// OpponentHoleCards[Perspective-1] instead of the hero's: Win, PotShare
+	// and the other tallies are that opponent's against the field, with the
+	// hero taking its place in HeadToHead.
+	Perspective int
+	// FoldFrequency, when positive, is the probability that the field folds
+	// to the hero's bet, winning the pot without a showdown; otherwise the
+	// opponents call with their hands or OpponentRange. The results combine
+	// both, with the folds reported as FoldEquity. It must be 0-1 and cannot
+	// be combined with Perspective.
+	FoldFrequency float64
+}
+
+// Evaluate finds the best 5-card poker hand from 1-7 cards. It returns an
+// error when the cards cannot form a hand, such as a repeated card.
+func (e *Engine) Evaluate(cards []*card.Card) (*evaluator.HandResult, error) {
+	return evaluator.Evaluate(cards)
+}
+
+// EvaluateWithBoard finds the best hand from hole and board cards and
+// reports whether the hole cards play. Hole and board cards are checked
+// together as for Evaluate.
+func (e *Engine) EvaluateWithBoard(hole, board []*card.Card) (*evaluator.HandResult, error) {
+	if err := evaluator.CheckHand(append(append([]*card.Card{}, hole...), board...)); err != nil {
+		return nil, err
+	}
+	return evaluator.EvaluateWithBoard(hole, board), nil
+}
+
+// Odds runs Monte Carlo simulation to calculate poker odds.
+// It returns an error when the cards are invalid or the remaining deck or
+// opponent range cannot cover every deal. Seeded results are cached, and
+// repeating a seeded calculation returns the cached result with Cached
+// set; cached results are shared and must not be modified.
+func (e *Engine) Odds(params OddsParams) (*OddsResult, error) {
+	return e.OddsContext(context.Background(), params)
+}
+
+// OddsContext is Odds that stops early when ctx is done, returning
+// ctx.Err(). Workers check ctx between simulations, so a long calculation
+// ends soon after it is cancelled.
+func (e *Engine) OddsContext(ctx context.Context, params OddsParams) (*OddsResult, error) {
+	if err := checkCards(params); err != nil {
+		return nil, err
+	}
+	params, err := applyRangeFilter(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeckSize(params); err != nil {
+		return nil, err
+	}
+	if err := checkFoldFrequency(params); err != nil {
+		return nil, err
+	}
+
+	simulations, workers, err := e.simulationCounts(params.Simulations, params.Workers)
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	if params.Seed != nil {
+		key = params.cacheKey("odds", simulations, workers)
+		if result, ok := e.results.get(key); ok {
+			return result, nil
+		}
+	}
+
+	run := simulations
+	if params.TargetMargin > 0 {
+		run = min(simulations, pilotSimulations)
+	}
+	merged, rates := e.simulate(ctx, params, run, workers, 0)
+	if merged.err != nil {
+		return nil, merged.err
+	}
+
+	// Seeded simulations draw from streams indexed by their position, so
+	// the extra chunks give the same result as one run of the total.
+	if params.TargetMargin > 0 {
+		chunk := params.chunkSize()
+		for run < simulations {
+			target := min(simulations, targetSimulations(merged, params.TargetMargin, params.boards()))
+			if target <= run {
+				break
+			}
+			next := min(chunk, target-run)
+			extra, extraRates := e.simulate(ctx, params, next, workers, run)
+			if extra.err != nil {
+				return nil, extra.err
+			}
+			merged, _ = mergeResults([]workerResult{merged, extra})
+			rates = append(rates, extraRates...)
+			run += next
+		}
+	}
+
+	result := newOddsResult(params, merged)
+	result.StandardError = math.Sqrt(result.Win * (1 - result.Win) / float64(merged.showdowns))
+	result.WorkerWinVariance, result.WorkerWinStdDev = winRateSpread(rates)
+	if params.TargetMargin > 0 {
+		result.Margin = tieZScore * result.PotShareStandardError()
+	}
+	applyFoldEquity(result, params.FoldFrequency)
+	if key != "" {
+		e.results.put(key, result)
+	}
+	return result, nil
+}
+
+// pilotSimulations is the size of the first run of a TargetMargin
+// calculation, from which the variance is estimated.
+const pilotSimulations = 1000
+
+// defaultChunkSize is the ChunkSize of a TargetMargin calculation that
+// does not set one.
+const defaultChunkSize = 10000
+
+// chunkSize returns ChunkSize, or defaultChunkSize when it is below 1.
+func (p OddsParams) chunkSize() int {
+	if p.ChunkSize < 1 {
+		return defaultChunkSize
+	}
+	return p.ChunkSize
+}
+
+// targetSimulations returns the simulations needed for the 95% confidence
+// interval of the pot share to be within ±margin, estimating the variance
+// of a showdown from the pilot tallies.
+func targetSimulations(pilot workerResult, margin float64, boards int) int {
+	showdowns := float64(pilot.showdowns)
+	win := float64(pilot.wins) / showdowns
+	share := win + pilot.splitShares/showdowns
+	variance := win + pilot.splitSquares/showdowns - share*share
+	return simulationsForMargin(variance, margin, boards)
+}
+
+// simulate runs simulations numbered from first, split across workers.
+func (e *Engine) simulate(ctx context.Context, params OddsParams, simulations, workers, first int) (workerResult, []float64) {
+	// Workers beyond one per simulation would have nothing to do.
+	if workers > simulations {
+		workers = simulations
+	}
+
+	shares := splitSimulations(simulations, workers)
+
+	switch {
+	case workers == 1 || simulations <= sequentialThreshold:
+		return mergeResults(e.runSequential(ctx, params, shares, first))
+	case workers > atomicWorkerThreshold:
+		return e.runAtomic(ctx, params, shares, first)
+	default:
+		return mergeResults(e.runParallel(ctx, params, shares, first))
+	}
+}
+
+// newOddsResult converts merged tallies into probabilities. The sampling
+// statistics are left for the caller, which knows how the tallies were
+// produced.
+func newOddsResult(params OddsParams, merged workerResult) *OddsResult {
+	showdowns := float64(merged.showdowns)
+
+	distribution := make(map[string]float64, len(merged.winningHands))
+	for rank, count := range merged.winningHands {
+		distribution[evaluator.HandRankNames[rank]] = float64(count) / showdowns
+	}
+
+	var matchups []HeadToHead
+	for _, count := range merged.headToHead {
+		matchups = append(matchups, HeadToHead{
+			Win:  float64(count.wins) / showdowns,
+			Tie:  float64(count.ties) / showdowns,
+			Loss: float64(merged.showdowns-count.wins-count.ties) / showdowns,
+		})
+	}
+
+	var losing []HoldingFrequency
+	if params.TopLosingHands > 0 {
+		losing = topHoldings(merged.losingHands, params.TopLosingHands, merged.showdowns)
+	}
+
+	win := float64(merged.wins) / showdowns
+	tie := float64(merged.ties) / showdowns
+
+	return &OddsResult{
+		Win:                     win,
+		Tie:                     tie,
+		Loss:                    float64(merged.showdowns-merged.wins-merged.ties) / showdowns,
+		PotShare:                win + merged.splitShares/showdowns,
+		Simulations:             merged.simulations,
+		Showdowns:               merged.showdowns,
+		WinningHandDistribution: distribution,
+		HeadToHead:              matchups,
+		TopLosingHands:          losing,
+		Diagnostics: Diagnostics{
+			DeadCards:      params.DeadCards,
+			BurnedCards:    params.BurnedCards,
+			KnownOutOfPlay: params.KnownOutOfPlay,
+			DeckSize:       len(deckCards) - len(params.knownCards()),
+		},
+		shareSquares: win + merged.splitSquares/showdowns,
+	}
+}
+
+// sequentialThreshold is the simulation count at or below which workers'
+// shares run one after another on the calling goroutine. A simulation costs
+// tens of microseconds, so only very small requests are dominated by
+// goroutine and channel setup.
+const sequentialThreshold = 16
+
+// simulationCounts applies the engine defaults to the simulations and
+// workers a calculation asks for. It fails when an engine default leaves
+// either below 1, which would otherwise divide by zero.
+func (e *Engine) simulationCounts(simulations, workers int) (int, int, error) {
+	if workers < 1 {
+		workers = e.DefaultWorkers
+	}
+	if simulations < 1 {
+		simulations = e.DefaultSimulations
+	}
+	if simulations < 1 || workers < 1 {
+		return 0, 0, newDealError(ErrNoSimulations, fmt.Sprintf(
+			"no simulations to run: %d simulations on %d workers", simulations, workers))
+	}
+	return simulations, workers, nil
+}
+
+// splitSimulations divides simulations as evenly as possible across workers.
+// Workers beyond one per simulation would have nothing to do, so there are
+// never more shares than simulations.
+func splitSimulations(simulations, workers int) []int {
+	workers = min(workers, simulations)
+	shares := make([]int, workers)
+	for i := range shares {
+		shares[i] = simulations / workers
+		if i < simulations%workers {
+			shares[i]++
+		}
+	}
+	return shares
+}
+
+// shareOffsets returns the global index of each worker's first simulation
+// when the shares start at simulation first.
+func shareOffsets(shares []int, first int) []int {
+	offsets := make([]int, len(shares))
+	offsets[0] = first
+	for i := 1; i < len(shares); i++ {
+		offsets[i] = offsets[i-1] + shares[i-1]
+	}
+	return offsets
+}
+
+// runSequential runs each worker's share in turn without goroutines or
+// channels. Results match runParallel for the same seed and shares.
+func (e *Engine) runSequential(ctx context.Context, params OddsParams, shares []int, first int) []workerResult {
+	results := make([]workerResult, len(shares))
+	offsets := shareOffsets(shares, first)
+	e.acquireWorker()
+	defer e.releaseWorker()
+	for i, sims := range shares {
+		deck := e.decks.get(params.knownCards())
+		results[i] = runSimulations(ctx, params, deck, sims, e.workerRand(params.Seed, offsets[i]), nil)
+	}
+	return results
+}
+
+// runParallel runs each worker's share on its own goroutine and collects
+// the results over a channel, in worker order.
+func (e *Engine) runParallel(ctx context.Context, params OddsParams, shares []int, first int) []workerResult {
+	type indexedResult struct {
+		worker int
+		result workerResult
+	}
+
+	var wg sync.WaitGroup
+	results := make(chan indexedResult, len(shares))
+	offsets := shareOffsets(shares, first)
+
+	// Launch worker goroutines
+	for i, sims := range shares {
+		wg.Add(1)
+
+		rng := e.workerRand(params.Seed, offsets[i])
+		go func(i, sims int) {
+			defer wg.Done()
+			e.acquireWorker()
+			defer e.releaseWorker()
+			deck := e.decks.get(params.knownCards())
+			results <- indexedResult{i, runSimulations(ctx, params, deck, sims, rng, nil)}
+		}(i, sims)
+	}
+
+	// Close channel when all workers finish
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	collected := make([]workerResult, len(shares))
+	for indexed := range results {
+		collected[indexed.worker] = indexed.result
+	}
+	return collected
+}
+
+// checkCards verifies that the hero has exactly two hole cards, or none
+// with a hero range, or HoleUse to 7 with HoleUse set, that the board has
+// at most five cards, and that no known card appears twice.
+func checkCards(params OddsParams) error {
+	if params.HoleUse != 0 {
+		if err := checkHoleUse(params); err != nil {
+			return err
+		}
+		if len(params.BoardCards) > 5 {
+			return newDealError(ErrInvalidCards, fmt.Sprintf(
+				"board cannot have more than 5 cards, got %d", len(params.BoardCards)))
+		}
+		return card.CheckUnique(params.knownCards())
+	}
+
+	switch {
+	case params.HeroRange != nil && len(params.HoleCards) > 0:
+		return newDealError(ErrInvalidCards, "hole cards cannot be combined with a hero range")
+	case params.HeroRange == nil && len(params.HoleCards) != 2:
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"hero must have exactly 2 hole cards, got %d", len(params.HoleCards)))
+	case len(params.BoardCards) > 5:
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"board cannot have more than 5 cards, got %d", len(params.BoardCards)))
+	}
+	return card.CheckUnique(params.knownCards())
+}
+
+// maxHoleUse is the most hole cards an Omaha-style hand may be made with.
+const maxHoleUse = 4
+
+// checkHoleUse verifies the hero's hand and options for an Omaha-style
+// game.
+func checkHoleUse(params OddsParams) error {
+	switch {
+	case params.HoleUse < 1 || params.HoleUse > maxHoleUse:
+		return newDealError(ErrHoleUse, fmt.Sprintf("hole use must be 1-%d, got %d", maxHoleUse, params.HoleUse))
+	case params.HeroRange != nil || params.OpponentRange != nil:
+		return newDealError(ErrHoleUse, "ranges cannot be combined with hole use")
+	case params.TopLosingHands > 0:
+		return newDealError(ErrHoleUse, "top losing hands cannot be combined with hole use")
+	case len(params.HoleCards) < params.HoleUse || len(params.HoleCards) > 7:
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"hero must have %d-7 hole cards to use %d, got %d", params.HoleUse, params.HoleUse, len(params.HoleCards)))
+	}
+	return nil
+}
+
+// holeSize returns the number of hole cards each player is dealt.
+func (p OddsParams) holeSize() int {
+	if p.HoleUse > 0 {
+		return len(p.HoleCards)
+	}
+	return 2
+}
+
+// checkDeckSize verifies that there is at least one opponent, that enough
+// cards remain after completing the board to deal two hole cards to every
+// opponent and folded player, and the missing card of partially known
+// hands, and that an opponent range still has combos once known cards are
+// removed.
+func checkDeckSize(params OddsParams) error {
+	if params.NumOpponents < 1 {
+		return newDealError(ErrNoOpponents, fmt.Sprintf(
+			"at least 1 opponent is required, got %d", params.NumOpponents))
+	}
+	if len(params.OpponentHoleCards) > params.NumOpponents {
+		return newDealError(ErrOpponentHands, fmt.Sprintf(
+			"given %d opponent hands for %d opponents", len(params.OpponentHoleCards), params.NumOpponents))
+	}
+	if params.Perspective < 0 || params.Perspective > len(params.OpponentHoleCards) {
+		return newDealError(ErrOpponentHands, fmt.Sprintf(
+			"perspective %d needs a fixed opponent hand, got %d", params.Perspective, len(params.OpponentHoleCards)))
+	}
+	partial := 0
+	for i, hand := range params.OpponentHoleCards {
+		if params.HoleUse > 0 && len(hand) != params.holeSize() {
+			return newDealError(ErrOpponentHands, fmt.Sprintf(
+				"opponent %d must have %d hole cards, got %d", i+1, params.holeSize(), len(hand)))
+		}
+		if params.HoleUse == 0 && (len(hand) < 1 || len(hand) > 2) {
+			return newDealError(ErrOpponentHands, fmt.Sprintf(
+				"opponent %d must have 1 or 2 hole cards, got %d", i+1, len(hand)))
+		}
+		if len(hand) == 1 {
+			partial++
+		}
+	}
+
+	if params.boards() > 1 && len(params.BoardCards) > 0 {
+		return newDealError(ErrMultipleBoards, "multiple boards cannot start from known board cards")
+	}
+
+	known := params.knownCards()
+	if params.OpponentRange != nil && len(params.OpponentRange.Without(known)) == 0 {
+		return newDealError(ErrEmptyRange, "opponent range has no combos left after removing known cards")
+	}
+	if params.HeroRange != nil && len(params.HeroRange.Without(known)) == 0 {
+		return newDealError(ErrEmptyRange, "hero range has no combos left after removing known cards")
+	}
+
+	deck := card.RemoveCards(card.NewDeck(), known)
+
+	remaining := len(deck) - params.boards()*(5-len(params.BoardCards))
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	needed := params.holeSize()*(params.NumOpponents-len(params.OpponentHoleCards)+params.FoldedPlayers) + partial
+	if params.HeroRange != nil {
+		needed += 2
+	}
+	if needed <= remaining {
+		return nil
+	}
+
+	if params.FoldedPlayers > 0 {
+		return newDealError(ErrInsufficientCards, fmt.Sprintf(
+			"requested %d opponents and %d folded players requires %d cards but only %d remain",
+			params.NumOpponents, params.FoldedPlayers, needed, remaining))
+	}
+	return newDealError(ErrInsufficientCards, fmt.Sprintf(
+		"requested %d opponents requires %d cards but only %d remain",
+		params.NumOpponents, needed, remaining))
+}
+
+// boards returns the number of community boards to deal.
+func (p OddsParams) boards() int {
+	if p.Boards < 1 {
+		return 1
+	}
+	return p.Boards
+}
+
+// knownCards returns every card that cannot be dealt during simulation.
+func (p OddsParams) knownCards() []*card.Card {
+	removed := p.outOfPlay()
+	known := make([]*card.Card, 0, len(p.HoleCards)+len(p.BoardCards)+len(removed)+2*len(p.OpponentHoleCards))
+	known = append(known, p.HoleCards...)
+	known = append(known, p.BoardCards...)
+	known = append(known, removed...)
+	for _, hand := range p.OpponentHoleCards {
+		known = append(known, hand...)
+	}
+	return known
+}
+
+// outOfPlay returns every card removed from the deck without belonging to
+// a live hand or the board: DeadCards, BurnedCards and KnownOutOfPlay.
+func (p OddsParams) outOfPlay() []*card.Card {
+	removed := make([]*card.Card, 0, len(p.DeadCards)+len(p.BurnedCards)+len(p.KnownOutOfPlay))
+	removed = append(removed, p.DeadCards...)
+	removed = append(removed, p.BurnedCards...)
+	return append(removed, p.KnownOutOfPlay...)
+}
@@ -0,0 +1,235 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// TestDeckSizeBoundary fills the deck with dead cards until the opponents
+// need exactly what is left, then one card more.
+func TestDeckSizeBoundary(t *testing.T) {
+	hole := mustCards(t, "As Ks")
+	board := mustCards(t, "Qs Jd 7c 4h 2s")
+	deck := card.RemoveCards(card.NewDeck(), append(append([]*card.Card{}, hole...), board...))
+
+	// 45 cards remain; nine opponents need 18 of them.
+	params := OddsParams{
+		HoleCards:    hole,
+		BoardCards:   board,
+		NumOpponents: 9,
+		DeadCards:    deck[:27],
+		Simulations:  10,
+		Workers:      1,
+	}
+	if _, err := NewEngine().Odds(params); err != nil {
+		t.Fatalf("18 cards left for 9 opponents: %v", err)
+	}
+
+	params.DeadCards = deck[:28]
+	_, err := NewEngine().Odds(params)
+	if !errors.Is(err, ErrInsufficientCards) {
+		t.Fatalf("17 cards left for 9 opponents: err = %v, want ErrInsufficientCards", err)
+	}
+	const want = "requested 9 opponents requires 18 cards but only 17 remain"
+	if err.Error() != want {
+		t.Errorf("err = %q, want %q", err, want)
+	}
+}
+
+// TestSequentialMatchesParallel checks that the single-goroutine path for
+// small requests gives the same tallies as the worker goroutines.
+func TestSequentialMatchesParallel(t *testing.T) {
+	engine := NewEngine()
+	params := OddsParams{
+		HoleCards:    mustCards(t, "Ah Kh"),
+		BoardCards:   mustCards(t, "Qh 7h 2c"),
+		NumOpponents: 3,
+		Seed:         seed(7),
+	}
+	shares := splitSimulations(400, 4)
+
+	sequential, _ := mergeResults(engine.runSequential(context.Background(), params, shares, 0))
+	parallel, _ := mergeResults(engine.runParallel(context.Background(), params, shares, 0))
+	if sequential.wins != parallel.wins || sequential.ties != parallel.ties ||
+		sequential.showdowns != parallel.showdowns || sequential.splitShares != parallel.splitShares {
+		t.Errorf("sequential %d/%d/%d/%g, parallel %d/%d/%d/%g (wins/ties/showdowns/split shares)",
+			sequential.wins, sequential.ties, sequential.showdowns, sequential.splitShares,
+			parallel.wins, parallel.ties, parallel.showdowns, parallel.splitShares)
+	}
+}
+
+// TestTinyRequestsLeaveNoIdleWorkers checks that requests with fewer
+// simulations than workers use one worker per simulation and run exactly
+// the simulations requested.
+func TestTinyRequestsLeaveNoIdleWorkers(t *testing.T) {
+	for _, tc := range []struct{ simulations, workers int }{{1, 8}, {3, 8}, {40, 100}, {17, 4}} {
+		shares := splitSimulations(tc.simulations, tc.workers)
+		total := 0
+		for _, share := range shares {
+			if share < 1 {
+				t.Errorf("%d simulations on %d workers: idle worker in %v", tc.simulations, tc.workers, shares)
+			}
+			total += share
+		}
+		if want := min(tc.simulations, tc.workers); len(shares) != want {
+			t.Errorf("%d simulations on %d workers: %d shares, want %d", tc.simulations, tc.workers, len(shares), want)
+		}
+		if total != tc.simulations {
+			t.Errorf("%d simulations on %d workers: shares add up to %d", tc.simulations, tc.workers, total)
+		}
+
+		result, err := NewEngine().Odds(OddsParams{
+			HoleCards:    mustCards(t, "As Ks"),
+			NumOpponents: 2,
+			Simulations:  tc.simulations,
+			Workers:      tc.workers,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Simulations != tc.simulations || result.Showdowns != tc.simulations {
+			t.Errorf("%d simulations on %d workers: ran %d simulations with %d showdowns",
+				tc.simulations, tc.workers, result.Simulations, result.Showdowns)
+		}
+	}
+}
+
+// TestNoSimulations checks that an engine whose defaults leave nothing to
+// run fails every calculation with ErrNoSimulations instead of reporting
+// NaN or dividing by zero workers.
+func TestNoSimulations(t *testing.T) {
+	hole := mustCards(t, "As Ks")
+	for _, defaults := range []struct{ simulations, workers int }{{0, 4}, {1000, 0}, {-1, -1}} {
+		engine := NewEngine()
+		engine.DefaultSimulations = defaults.simulations
+		engine.DefaultWorkers = defaults.workers
+
+		calculations := map[string]func() error{
+			"Odds": func() error {
+				_, err := engine.Odds(OddsParams{HoleCards: hole, NumOpponents: 1})
+				return err
+			},
+			"DrawOdds": func() error {
+				_, err := engine.DrawOdds(DrawParams{HoleCards: mustCards(t, "As Ks Qs Js 2d"), NumOpponents: 1})
+				return err
+			},
+			"AllInEquity": func() error {
+				_, err := engine.AllInEquity(AllInParams{Players: []AllInPlayer{
+					{HoleCards: hole, Committed: 100},
+					{HoleCards: mustCards(t, "Qd Qc"), Committed: 100},
+				}})
+				return err
+			},
+			"HandOutcomes": func() error {
+				_, err := engine.HandOutcomes(OutcomeParams{HoleCards: hole})
+				return err
+			},
+		}
+		for name, calculate := range calculations {
+			if err := calculate(); !errors.Is(err, ErrNoSimulations) {
+				t.Errorf("%s with default simulations %d and workers %d: err = %v, want ErrNoSimulations",
+					name, defaults.simulations, defaults.workers, err)
+			}
+		}
+	}
+}
+
+// TestSeededResultsIgnoreWorkers checks that a seeded calculation deals
+// the same simulations however many workers split them.
+func TestSeededResultsIgnoreWorkers(t *testing.T) {
+	opponents, err := handrange.Parse("QQ+, AKs, AQo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var first *OddsResult
+	for _, workers := range []int{1, 3, 8} {
+		result, err := NewEngine().Odds(OddsParams{
+			HoleCards:      mustCards(t, "Jh Th"),
+			NumOpponents:   2,
+			OpponentRange:  opponents,
+			TopLosingHands: 5,
+			Simulations:    3000,
+			Workers:        workers,
+			Seed:           seed(42),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first == nil {
+			first = result
+			continue
+		}
+		if result.Win != first.Win || result.Tie != first.Tie || result.Showdowns != first.Showdowns ||
+			math.Abs(result.PotShare-first.PotShare) > 1e-12 {
+			t.Errorf("%d workers: win %g, tie %g, pot share %g; 1 worker: %g, %g, %g",
+				workers, result.Win, result.Tie, result.PotShare, first.Win, first.Tie, first.PotShare)
+		}
+		if !reflect.DeepEqual(result.WinningHandDistribution, first.WinningHandDistribution) {
+			t.Errorf("%d workers: winning hands %v, 1 worker: %v", workers, result.WinningHandDistribution, first.WinningHandDistribution)
+		}
+		if !reflect.DeepEqual(result.TopLosingHands, first.TopLosingHands) {
+			t.Errorf("%d workers: top losing hands %v, 1 worker: %v", workers, result.TopLosingHands, first.TopLosingHands)
+		}
+	}
+}
+
+// TestSeededAllInIgnoresWorkers is TestSeededResultsIgnoreWorkers for
+// all-in showdowns.
+func TestSeededAllInIgnoresWorkers(t *testing.T) {
+	params := AllInParams{
+		Players: []AllInPlayer{
+			{HoleCards: mustCards(t, "As Ah"), Committed: 100},
+			{HoleCards: mustCards(t, "Kd Kc"), Committed: 250},
+			{HoleCards: mustCards(t, "8h 7h"), Committed: 400},
+		},
+		Simulations: 2000,
+		Seed:        seed(42),
+	}
+
+	params.Workers = 1
+	single, err := NewEngine().AllInEquity(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params.Workers = 7
+	split, err := NewEngine().AllInEquity(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range single.Players {
+		if math.Abs(single.Players[i].ExpectedChips-split.Players[i].ExpectedChips) > 1e-9 {
+			t.Errorf("player %d: %g chips with 1 worker, %g with 7", i, single.Players[i].ExpectedChips, split.Players[i].ExpectedChips)
+		}
+	}
+}
+
+// benchmarkSmallRequest measures the latency of a request small enough
+// for setup to dominate, run by the given path.
+func benchmarkSmallRequest(b *testing.B, run func(*Engine, context.Context, OddsParams, []int, int) []workerResult) {
+	engine := NewEngine()
+	params := OddsParams{
+		HoleCards:    mustCards(b, "As Ks"),
+		NumOpponents: 1,
+		Seed:         seed(1),
+	}
+	shares := splitSimulations(sequentialThreshold, 4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mergeResults(run(engine, context.Background(), params, shares, 0))
+	}
+}
+
+func BenchmarkSmallRequestSequential(b *testing.B) {
+	benchmarkSmallRequest(b, (*Engine).runSequential)
+}
+
+func BenchmarkSmallRequestParallel(b *testing.B) {
+	benchmarkSmallRequest(b, (*Engine).runParallel)
+}
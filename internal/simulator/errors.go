@@ -0,0 +1,72 @@
+package simulator
+
+import "errors"
+
+// Errors returned by Engine.Odds for calculations that cannot be dealt.
+// Use errors.Is to test for them; the returned error's message describes
+// the specific request.
+var (
+	// ErrInvalidCards means the hero's hole cards or the board have the
+	// wrong number of cards.
+	ErrInvalidCards = errors.New("invalid card count")
+	// ErrInsufficientCards means the deck cannot complete the board and deal
+	// every player.
+	ErrInsufficientCards = errors.New("not enough cards to deal")
+	// ErrEmptyRange means an opponent range has no combos left after
+	// removing known cards.
+	ErrEmptyRange = errors.New("opponent range is empty")
+	// ErrRangeConflict means opponent ranges are too narrow to deal every
+	// opponent a combo without sharing cards.
+	ErrRangeConflict = errors.New("unable to deal opponent ranges without card conflicts")
+	// ErrOpponentHands means the fixed opponent hole cards do not fit the
+	// requested opponents.
+	ErrOpponentHands = errors.New("invalid opponent hole cards")
+	// ErrNoOpponents means fewer than one opponent was requested, leaving
+	// no one to compare the hero against.
+	ErrNoOpponents = errors.New("at least one opponent is required")
+	// ErrAllInPlayers means an all-in showdown has too few players or a
+	// player without two hole cards or committed chips.
+	ErrAllInPlayers = errors.New("invalid all-in players")
+	// ErrMultipleBoards means multiple boards were requested with known
+	// board cards, which only one board can hold.
+	ErrMultipleBoards = errors.New("multiple boards require an empty board")
+	// ErrNotEnumerable means ExactOdds was asked for a calculation with
+	// random holdings, which only simulation supports.
+	ErrNotEnumerable = errors.New("calculation cannot be enumerated exactly")
+	// ErrHoleUse means OddsParams.HoleUse is out of range or combined with
+	// an option that assumes two-card holdings.
+	ErrHoleUse = errors.New("invalid hole card use")
+	// ErrTooManyRunouts means exact enumeration would exceed the engine's
+	// MaxRunouts.
+	ErrTooManyRunouts = errors.New("too many runouts to enumerate")
+	// ErrFoldFrequency means OddsParams.FoldFrequency is not a probability
+	// or is combined with a perspective.
+	ErrFoldFrequency = errors.New("invalid fold frequency")
+	// ErrRangeFilter means OddsParams.OpponentFilter was set without
+	// an opponent range or a flop to filter it on.
+	ErrRangeFilter = errors.New("invalid range filter")
+	// ErrNoSimulations means a calculation would run no simulations, as
+	// when the engine's DefaultSimulations or DefaultWorkers is below 1.
+	ErrNoSimulations = errors.New("no simulations to run")
+)
+
+// dealError pairs a request-specific message with one of the sentinels.
+type dealError struct {
+	kind    error
+	message string
+}
+
+// newDealError creates an error matching kind with the given message.
+func newDealError(kind error, message string) error {
+	return &dealError{kind: kind, message: message}
+}
+
+// Error implements the error interface.
+func (e *dealError) Error() string {
+	return e.message
+}
+
+// Unwrap lets errors.Is match the sentinel.
+func (e *dealError) Unwrap() error {
+	return e.kind
+}
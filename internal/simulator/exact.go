@@ -0,0 +1,208 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// ExactOdds enumerates every completion of the board instead of sampling,
+// returning exact probabilities. Every opponent's hole cards must be given
+// in OpponentHoleCards; ranges, folded players and multiple boards are not
+// supported. Simulations, Workers and Seed are ignored.
+//
+// Runouts that differ only by swapping suits the known cards cannot tell
+// apart have the same outcome, so each such class is evaluated once and
+// counted with its size. Showdowns reports every runout and Classes the
+// number evaluated. Results are cached like seeded Odds results.
+func (e *Engine) ExactOdds(params OddsParams) (*OddsResult, error) {
+	if err := checkCards(params); err != nil {
+		return nil, err
+	}
+	params, err := applyRangeFilter(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeckSize(params); err != nil {
+		return nil, err
+	}
+	if err := checkEnumerable(params); err != nil {
+		return nil, err
+	}
+	if err := checkFoldFrequency(params); err != nil {
+		return nil, err
+	}
+
+	key := params.cacheKey("exact", 0, 0)
+	if result, ok := e.results.get(key); ok {
+		return result, nil
+	}
+
+	deck := newIndexDeck(params.knownCards())
+	missing := 5 - len(params.BoardCards)
+	if runouts := binomial(len(deck), missing); e.MaxRunouts > 0 && runouts > e.MaxRunouts {
+		return nil, newDealError(ErrTooManyRunouts, fmt.Sprintf(
+			"exact odds need %d runouts but the limit is %d", runouts, e.MaxRunouts))
+	}
+
+	classes := runoutClasses(deck, missing, suitSymmetries(params))
+
+	merged := newWorkerResult(params)
+	fullBoard := make([]*card.Card, 0, 5)
+	for key, weight := range classes {
+		fullBoard = append(fullBoard[:0], params.BoardCards...)
+		for i := range deckCards {
+			if key&(1<<uint(i)) != 0 {
+				fullBoard = append(fullBoard, deckCards[i])
+			}
+		}
+		merged.addShowdown(params.HoleCards, params.OpponentHoleCards, fullBoard, weight)
+	}
+	merged.simulations = merged.showdowns
+
+	result := newOddsResult(params, merged)
+	result.Classes = len(classes)
+	applyFoldEquity(result, params.FoldFrequency)
+	e.results.put(key, result)
+	return result, nil
+}
+
+// checkEnumerable rejects calculations with holdings dealt at random.
+func checkEnumerable(params OddsParams) error {
+	switch {
+	case params.HeroRange != nil || len(params.HoleCards) != params.holeSize():
+		return newDealError(ErrNotEnumerable, "exact odds need the hero's hole cards")
+	case len(params.OpponentHoleCards) != params.NumOpponents:
+		return newDealError(ErrNotEnumerable, fmt.Sprintf(
+			"exact odds need hole cards for all %d opponents, got %d", params.NumOpponents, len(params.OpponentHoleCards)))
+	case hasPartialHand(params.OpponentHoleCards):
+		return newDealError(ErrNotEnumerable, "exact odds need both hole cards of every opponent")
+	case params.OpponentRange != nil || params.HeroRange != nil:
+		return newDealError(ErrNotEnumerable, "exact odds do not support ranges")
+	case params.FoldedPlayers > 0:
+		return newDealError(ErrNotEnumerable, "exact odds do not support folded players")
+	case params.boards() > 1:
+		return newDealError(ErrNotEnumerable, "exact odds do not support multiple boards")
+	}
+	return nil
+}
+
+// hasPartialHand reports whether any opponent has only one known card.
+func hasPartialHand(hands [][]*card.Card) bool {
+	for _, hand := range hands {
+		if len(hand) < 2 {
+			return true
+		}
+	}
+	return false
+}
+
+// suitPermutations lists all 24 orderings of the four suit indexes.
+var suitPermutations = func() [][4]uint8 {
+	var perms [][4]uint8
+	var build func(perm [4]uint8, used [4]bool, n int)
+	build = func(perm [4]uint8, used [4]bool, n int) {
+		if n == 4 {
+			perms = append(perms, perm)
+			return
+		}
+		for s := uint8(0); s < 4; s++ {
+			if !used[s] {
+				used[s] = true
+				perm[n] = s
+				build(perm, used, n+1)
+				used[s] = false
+			}
+		}
+	}
+	build([4]uint8{}, [4]bool{}, 0)
+	return perms
+}()
+
+// suitSymmetries returns the suit permutations that leave every known card
+// group unchanged: the hero's hand, each opponent's hand, the board and
+// the removed cards. Applying one to a runout gives another runout with
+// the same outcome. The identity is always included.
+func suitSymmetries(params OddsParams) [][4]uint8 {
+	groups := [][]*card.Card{params.HoleCards, params.BoardCards, params.outOfPlay()}
+	groups = append(groups, params.OpponentHoleCards...)
+
+	// signature[s] holds the ranks each group has in suit s.
+	signature := make([][]uint16, 4)
+	for s := range signature {
+		signature[s] = make([]uint16, len(groups))
+	}
+	for g, group := range groups {
+		for _, c := range group {
+			signature[card.SuitIndex(c.Suit)][g] |= 1 << uint(c.RankValue())
+		}
+	}
+
+	var symmetries [][4]uint8
+	for _, perm := range suitPermutations {
+		preserved := true
+		for s := 0; s < 4 && preserved; s++ {
+			for g := range groups {
+				if signature[s][g] != signature[perm[s]][g] {
+					preserved = false
+					break
+				}
+			}
+		}
+		if preserved {
+			symmetries = append(symmetries, perm)
+		}
+	}
+	return symmetries
+}
+
+// rankBits masks one suit's block of deck indexes.
+const rankBits = 1<<13 - 1
+
+// permuteSuits moves each suit's block of a card mask to its image under
+// perm.
+func permuteSuits(mask uint64, perm [4]uint8) uint64 {
+	var permuted uint64
+	for s := uint(0); s < 4; s++ {
+		block := mask >> (13 * s) & rankBits
+		permuted |= block << (13 * uint(perm[s]))
+	}
+	return permuted
+}
+
+// runoutClasses enumerates every choice of n cards from deck as a mask of
+// deck indexes and groups them by suit symmetry. Each class is keyed by
+// its smallest member, itself a valid runout, and maps to the class size.
+func runoutClasses(deck []uint8, n int, symmetries [][4]uint8) map[uint64]int {
+	classes := make(map[uint64]int)
+	var choose func(start int, mask uint64, left int)
+	choose = func(start int, mask uint64, left int) {
+		if left == 0 {
+			key := mask
+			for _, perm := range symmetries {
+				if permuted := permuteSuits(mask, perm); permuted < key {
+					key = permuted
+				}
+			}
+			classes[key]++
+			return
+		}
+		for i := start; i <= len(deck)-left; i++ {
+			choose(i+1, mask|1<<uint(deck[i]), left-1)
+		}
+	}
+	choose(0, 0, n)
+	return classes
+}
+
+// binomial returns n choose k.
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+	}
+	return result
+}
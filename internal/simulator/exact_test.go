@@ -0,0 +1,87 @@
+package simulator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// naiveExactOdds enumerates every runout of params on its own, with no
+// suit symmetry, as ExactOdds would if no two suits were alike.
+func naiveExactOdds(params OddsParams) *OddsResult {
+	deck := newIndexDeck(params.knownCards())
+	identity := [][4]uint8{{0, 1, 2, 3}}
+	merged := newWorkerResult(params)
+	fullBoard := make([]*card.Card, 0, 5)
+	for key, weight := range runoutClasses(deck, 5-len(params.BoardCards), identity) {
+		fullBoard = append(fullBoard[:0], params.BoardCards...)
+		for i := range deckCards {
+			if key&(1<<uint(i)) != 0 {
+				fullBoard = append(fullBoard, deckCards[i])
+			}
+		}
+		merged.addShowdown(params.HoleCards, params.OpponentHoleCards, fullBoard, weight)
+	}
+	merged.simulations = merged.showdowns
+	return newOddsResult(params, merged)
+}
+
+func TestExactOddsMatchesNaiveEnumeration(t *testing.T) {
+	tests := []struct {
+		name      string
+		hole      string
+		opponents []string
+		board     string
+		dead      string
+	}{
+		{"aces against kings on a paired flop", "As Ah", []string{"Ks Kh"}, "2d 2c 7s", ""},
+		{"suited against a pair", "As Ks", []string{"Qd Qh"}, "2c 7c 9h", ""},
+		{"rainbow flop without symmetry", "As Kh", []string{"Qs Qh"}, "2c 7d 9h", ""},
+		{"three-way with a dead card", "As Ah", []string{"Ks Kh", "Qd Jd"}, "2c 7s 9h", "3c"},
+		{"turn", "As Kd", []string{"Qs Qh"}, "2c 7d 9h Jc", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := OddsParams{
+				HoleCards:    mustCards(t, tt.hole),
+				BoardCards:   mustCards(t, tt.board),
+				NumOpponents: len(tt.opponents),
+				DeadCards:    mustCards(t, tt.dead),
+			}
+			for _, hand := range tt.opponents {
+				params.OpponentHoleCards = append(params.OpponentHoleCards, mustCards(t, hand))
+			}
+
+			exact, err := NewEngine().ExactOdds(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			naive := naiveExactOdds(params)
+			if exact.Classes > naive.Showdowns {
+				t.Errorf("%d classes for %d runouts", exact.Classes, naive.Showdowns)
+			}
+			reduced := *exact
+			reduced.Classes = 0
+			if !reflect.DeepEqual(&reduced, naive) {
+				t.Errorf("ExactOdds = %+v\nnaive = %+v", &reduced, naive)
+			}
+		})
+	}
+}
+
+func TestExactOddsReducesSymmetricRunouts(t *testing.T) {
+	result, err := NewEngine().ExactOdds(OddsParams{
+		HoleCards:         mustCards(t, "As Ah"),
+		BoardCards:        mustCards(t, "2d 2c 7s"),
+		NumOpponents:      1,
+		OpponentHoleCards: [][]*card.Card{mustCards(t, "Ks Kh")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Showdowns != 990 || result.Classes >= result.Showdowns {
+		t.Errorf("%d classes for %d runouts, want fewer classes than 990 runouts", result.Classes, result.Showdowns)
+	}
+}
@@ -0,0 +1,24 @@
+package simulator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+)
+
+// mustCards parses a whitespace-separated list of card codes such as
+// "As Ks 7d", failing the test on an invalid code.
+func mustCards(t testing.TB, codes string) []*card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(strings.Fields(codes))
+	if err != nil {
+		t.Fatalf("mustCards(%q): %v", codes, err)
+	}
+	return cards
+}
+
+// seed returns a pointer to a fixed seed for reproducible calculations.
+func seed(value int64) *int64 {
+	return &value
+}
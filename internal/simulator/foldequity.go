@@ -0,0 +1,36 @@
+package simulator
+
+import "fmt"
+
+// checkFoldFrequency verifies that OddsParams.FoldFrequency is a
+// probability and is reported from the hero's seat.
+func checkFoldFrequency(params OddsParams) error {
+	switch {
+	case params.FoldFrequency < 0 || params.FoldFrequency > 1:
+		return newDealError(ErrFoldFrequency, fmt.Sprintf("fold frequency must be 0-1, got %g", params.FoldFrequency))
+	case params.FoldFrequency > 0 && params.Perspective > 0:
+		return newDealError(ErrFoldFrequency, "fold frequency cannot be combined with a perspective")
+	}
+	return nil
+}
+
+// applyFoldEquity mixes in the deals the field folds, each a win for the
+// hero without a showdown. The showdown odds are weighted by the chance
+// of being called rather than sampling folds, which gives the same
+// expected result without spending simulations on hands nobody shows
+// down. HeadToHead, WinningHandDistribution and TopLosingHands stay
+// showdown statistics.
+func applyFoldEquity(result *OddsResult, foldFrequency float64) {
+	if foldFrequency <= 0 {
+		return
+	}
+	called := 1 - foldFrequency
+	result.ShowdownPotShare = result.PotShare
+	result.FoldEquity = foldFrequency
+	result.Win = foldFrequency + called*result.Win
+	result.Tie *= called
+	result.Loss *= called
+	result.PotShare = foldFrequency + called*result.PotShare
+	result.StandardError *= called
+	result.Margin *= called
+}
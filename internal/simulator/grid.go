@@ -0,0 +1,109 @@
+package simulator
+
+import (
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// GridParams describes a preflop equity grid against one opponent.
+type GridParams struct {
+	// OpponentHoleCards fixes the opponent's two hole cards. When empty,
+	// the opponent is dealt from OpponentRange, or at random without one.
+	OpponentHoleCards []*card.Card
+	OpponentRange     handrange.Range
+	WeightedRange     bool
+	DeadCards         []*card.Card
+	// Simulations is the number of simulations for each starting hand,
+	// DefaultGridSimulations when below 1. Workers and Seed apply to each
+	// hand as in OddsParams.
+	Simulations int
+	Workers     int
+	Seed        *int64
+}
+
+// DefaultGridSimulations is the per-hand simulation count of a grid that
+// requests none. A grid runs 169 calculations, so the engine's default
+// would make it very slow.
+const DefaultGridSimulations = 1000
+
+// GridCell is the hero's equity with one starting hand.
+type GridCell struct {
+	// Hand is the starting hand, such as "AA", "AKs", or "AKo".
+	Hand string `json:"hand"`
+	// Equity is the hero's pot share, averaged over the hand's combos.
+	Equity float64 `json:"equity"`
+	// Combos is the number of the hand's combos not blocked by known cards.
+	Combos int `json:"combos"`
+}
+
+// PreflopGrid calculates the hero's preflop equity with each of the 169
+// starting hands against the opponent, laid out as the usual 13x13 grid:
+// rows and columns run from ace to deuce, pairs lie on the diagonal,
+// suited hands above it and offsuit hands below. Each hand is simulated
+// as a hero range of its combos, so suits that clash with the opponent's
+// cards are weighed correctly.
+func (e *Engine) PreflopGrid(params GridParams) ([][]GridCell, error) {
+	var opponentHands [][]*card.Card
+	if len(params.OpponentHoleCards) > 0 {
+		opponentHands = [][]*card.Card{params.OpponentHoleCards}
+	}
+
+	known := make([]*card.Card, 0, len(params.OpponentHoleCards)+len(params.DeadCards))
+	known = append(known, params.OpponentHoleCards...)
+	known = append(known, params.DeadCards...)
+
+	simulations := params.Simulations
+	if simulations < 1 {
+		simulations = DefaultGridSimulations
+	}
+
+	ranks := len(card.RankOrder)
+	grid := make([][]GridCell, ranks)
+	for row := range grid {
+		grid[row] = make([]GridCell, ranks)
+		for col := range grid[row] {
+			hand := gridHand(row, col)
+			heroRange, err := handrange.Parse(hand)
+			if err != nil {
+				return nil, err
+			}
+
+			result, err := e.Odds(OddsParams{
+				NumOpponents:      1,
+				OpponentHoleCards: opponentHands,
+				OpponentRange:     params.OpponentRange,
+				HeroRange:         heroRange,
+				WeightedRange:     params.WeightedRange,
+				DeadCards:         params.DeadCards,
+				Simulations:       simulations,
+				Workers:           params.Workers,
+				Seed:              params.Seed,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			grid[row][col] = GridCell{
+				Hand:   hand,
+				Equity: result.PotShare,
+				Combos: len(heroRange.Without(known)),
+			}
+		}
+	}
+	return grid, nil
+}
+
+// gridHand names the starting hand at a grid position, where row and
+// column 0 are aces: suited above the diagonal, offsuit below.
+func gridHand(row, col int) string {
+	last := len(card.RankOrder) - 1
+	high, low := card.RankOrder[last-row], card.RankOrder[last-col]
+	switch {
+	case row == col:
+		return string(high) + string(low)
+	case row < col:
+		return string(high) + string(low) + "s"
+	default:
+		return string(low) + string(high) + "o"
+	}
+}
@@ -0,0 +1,63 @@
+package simulator
+
+import "math"
+
+// Matchup types returned by HeadToHead.Matchup.
+const (
+	// MatchupLocked: the hero wins every runout.
+	MatchupLocked = "locked"
+	// MatchupFreeroll: the hero never loses and wins some runouts outright,
+	// splitting the rest.
+	MatchupFreeroll = "freeroll"
+	// MatchupChop: every runout splits the pot.
+	MatchupChop = "chop"
+	// MatchupDominating: the hero wins most of the pot, like AK against AQ.
+	MatchupDominating = "dominating"
+	// MatchupFavorite: the hero is ahead, but short of dominating.
+	MatchupFavorite = "favorite"
+	// MatchupCoinFlip: both hands take close to half the pot.
+	MatchupCoinFlip = "coin flip"
+	// MatchupUnderdog: the hero is behind, but short of dominated.
+	MatchupUnderdog = "underdog"
+	// MatchupDominated: the opponent wins most of the pot.
+	MatchupDominated = "dominated"
+	// MatchupFreerolled: the hero never wins outright but can split, so
+	// the opponent is freerolling.
+	MatchupFreerolled = "freerolled"
+	// MatchupDrawingDead: the hero loses every runout.
+	MatchupDrawingDead = "drawing dead"
+)
+
+// dominationShare is the pot share from which a matchup is dominating, and
+// below 1-dominationShare dominated: roughly the 70/30 of a shared card
+// with the better kicker.
+const dominationShare = 0.7
+
+// Matchup classifies the hero's result against one opponent by its tie
+// structure first, then by the pot share the hero takes, with a tie worth
+// half the pot. The coin flip margin matches Summary's.
+func (h HeadToHead) Matchup() string {
+	share := h.Win + h.Tie/2
+	switch {
+	case h.Loss == 0 && h.Tie == 0:
+		return MatchupLocked
+	case h.Loss == 0 && h.Win == 0:
+		return MatchupChop
+	case h.Loss == 0:
+		return MatchupFreeroll
+	case h.Win == 0 && h.Tie == 0:
+		return MatchupDrawingDead
+	case h.Win == 0:
+		return MatchupFreerolled
+	case share >= dominationShare:
+		return MatchupDominating
+	case share <= 1-dominationShare:
+		return MatchupDominated
+	case math.Abs(share-0.5) < coinFlipMargin:
+		return MatchupCoinFlip
+	case share > 0.5:
+		return MatchupFavorite
+	default:
+		return MatchupUnderdog
+	}
+}
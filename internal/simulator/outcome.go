@@ -0,0 +1,138 @@
+package simulator
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// OutcomeParams describes the hero's cards for HandOutcomes.
+type OutcomeParams struct {
+	HoleCards  []*card.Card
+	BoardCards []*card.Card
+	DeadCards  []*card.Card
+	// Exact enumerates every board completion instead of sampling, like
+	// ExactOdds, and is subject to the engine's MaxRunouts.
+	Exact bool
+	// Simulations and Workers fall back to the engine defaults when below
+	// 1. They and Seed are ignored when Exact is set.
+	Simulations int
+	Workers     int
+	// Seed makes the calculation reproducible when set.
+	Seed *int64
+}
+
+// OutcomeResult is the distribution of the hero's final hand category.
+type OutcomeResult struct {
+	// Distribution maps every hand category, Royal Flush down to High
+	// Card, to the probability the hero finishes the river with it.
+	Distribution map[string]float64 `json:"distribution"`
+	// Runouts is the number of board completions counted.
+	Runouts int `json:"runouts"`
+	// Exact is set when every runout was enumerated.
+	Exact bool `json:"exact"`
+}
+
+// rankCounts tallies final hands by category.
+type rankCounts [evaluator.RoyalFlush + 1]int
+
+// HandOutcomes reports how often the hero's hand finishes as each category
+// once the board is complete. Opponents play no part: this is the hand's
+// own ceiling, not its equity.
+func (e *Engine) HandOutcomes(params OutcomeParams) (*OutcomeResult, error) {
+	odds := OddsParams{HoleCards: params.HoleCards, BoardCards: params.BoardCards, DeadCards: params.DeadCards}
+	if err := checkCards(odds); err != nil {
+		return nil, err
+	}
+
+	var counts rankCounts
+	if params.Exact {
+		deck := newIndexDeck(odds.knownCards())
+		missing := 5 - len(params.BoardCards)
+		if runouts := binomial(len(deck), missing); e.MaxRunouts > 0 && runouts > e.MaxRunouts {
+			return nil, newDealError(ErrTooManyRunouts, fmt.Sprintf(
+				"exact outcomes need %d runouts but the limit is %d", runouts, e.MaxRunouts))
+		}
+
+		hand := make([]*card.Card, 0, 7)
+		for key, weight := range runoutClasses(deck, missing, suitSymmetries(odds)) {
+			hand = append(append(hand[:0], params.HoleCards...), params.BoardCards...)
+			for i := range deckCards {
+				if key&(1<<uint(i)) != 0 {
+					hand = append(hand, deckCards[i])
+				}
+			}
+			counts[evaluator.EvaluateHand(hand).Rank] += weight
+		}
+	} else {
+		simulations, workers, err := e.simulationCounts(params.Simulations, params.Workers)
+		if err != nil {
+			return nil, err
+		}
+		if workers > simulations {
+			workers = simulations
+		}
+
+		known := odds.knownCards()
+		shares := splitSimulations(simulations, workers)
+		results := make([]rankCounts, len(shares))
+		offsets := shareOffsets(shares, 0)
+
+		var wg sync.WaitGroup
+		for i, sims := range shares {
+			wg.Add(1)
+
+			rng := e.workerRand(params.Seed, offsets[i])
+			go func(i, sims int) {
+				defer wg.Done()
+				e.acquireWorker()
+				defer e.releaseWorker()
+				deck := e.decks.get(known)
+				results[i] = runOutcomes(params, deck, sims, rng)
+			}(i, sims)
+		}
+		wg.Wait()
+
+		for _, result := range results {
+			for rank, count := range result {
+				counts[rank] += count
+			}
+		}
+	}
+
+	runouts := 0
+	for _, count := range counts {
+		runouts += count
+	}
+	distribution := make(map[string]float64, len(evaluator.HandRankNames))
+	for rank := evaluator.HighCard; rank <= evaluator.RoyalFlush; rank++ {
+		distribution[evaluator.HandRankNames[rank]] = float64(counts[rank]) / float64(runouts)
+	}
+	return &OutcomeResult{Distribution: distribution, Runouts: runouts, Exact: params.Exact}, nil
+}
+
+// runOutcomes completes the board simulations times for one worker and
+// counts the hero's final hand categories.
+func runOutcomes(params OutcomeParams, deck []uint8, simulations int, streams *simulationRand) rankCounts {
+	var counts rankCounts
+	hand := make([]*card.Card, 0, 7)
+	missing := 5 - len(params.BoardCards)
+	base := streams.baseDeck(deck)
+
+	for i := 0; i < simulations; i++ {
+		rng, reset := streams.advance()
+		if reset {
+			copy(deck, base)
+		}
+		shuffleDeck(deck, rng)
+
+		hand = append(append(hand[:0], params.HoleCards...), params.BoardCards...)
+		for next := 0; next < missing; next++ {
+			hand = append(hand, deckCards[deck[next]])
+		}
+		counts[evaluator.EvaluateHand(hand).Rank]++
+	}
+	return counts
+}
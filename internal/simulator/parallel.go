@@ -0,0 +1,91 @@
+package simulator
+
+import (
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Paths of the CPU quota under cgroup v2 and cgroup v1.
+const (
+	cgroupV2CPUMax    = "/sys/fs/cgroup/cpu.max"
+	cgroupV1CPUQuota  = "/sys/fs/cgroup/cpu/cpu.cfs_quota_us"
+	cgroupV1CPUPeriod = "/sys/fs/cgroup/cpu/cpu.cfs_period_us"
+)
+
+// EffectiveCPUs returns the number of CPUs the process can keep busy:
+// GOMAXPROCS, lowered to the container's cgroup CPU quota rounded up when
+// one is set. It is at least 1.
+func EffectiveCPUs() int {
+	cpus := runtime.GOMAXPROCS(0)
+	if quota, ok := cgroupCPUQuota(); ok {
+		cpus = min(cpus, int(math.Ceil(quota)))
+	}
+	return max(cpus, 1)
+}
+
+// cgroupCPUQuota reads the CPU quota in CPUs, such as 1.5, from cgroup v2
+// or else cgroup v1. It reports false when neither sets a limit.
+func cgroupCPUQuota() (float64, bool) {
+	if data, err := os.ReadFile(cgroupV2CPUMax); err == nil {
+		// cpu.max holds "$MAX $PERIOD", with MAX "max" when unlimited.
+		fields := strings.Fields(string(data))
+		if len(fields) != 2 {
+			return 0, false
+		}
+		return quotaRatio(fields[0], fields[1])
+	}
+
+	quota, err := os.ReadFile(cgroupV1CPUQuota)
+	if err != nil {
+		return 0, false
+	}
+	period, err := os.ReadFile(cgroupV1CPUPeriod)
+	if err != nil {
+		return 0, false
+	}
+	// An unlimited cgroup v1 quota is -1, which quotaRatio rejects.
+	return quotaRatio(strings.TrimSpace(string(quota)), strings.TrimSpace(string(period)))
+}
+
+// quotaRatio divides a cgroup quota by its period, reporting false unless
+// both are positive numbers.
+func quotaRatio(quota, period string) (float64, bool) {
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil || q <= 0 {
+		return 0, false
+	}
+	p, err := strconv.ParseFloat(period, 64)
+	if err != nil || p <= 0 {
+		return 0, false
+	}
+	return q / p, true
+}
+
+// acquireWorker blocks until a worker may run, when MaxParallel bounds the
+// engine's parallelism. Each call must be paired with releaseWorker.
+func (e *Engine) acquireWorker() {
+	if slots := e.workerSlots(); slots != nil {
+		slots <- struct{}{}
+	}
+}
+
+// releaseWorker frees the slot taken by acquireWorker.
+func (e *Engine) releaseWorker() {
+	if slots := e.workerSlots(); slots != nil {
+		<-slots
+	}
+}
+
+// workerSlots returns the semaphore shared by every calculation on the
+// engine, created from MaxParallel on first use, or nil when unbounded.
+func (e *Engine) workerSlots() chan struct{} {
+	e.slotsOnce.Do(func() {
+		if e.MaxParallel > 0 {
+			e.slots = make(chan struct{}, e.MaxParallel)
+		}
+	})
+	return e.slots
+}
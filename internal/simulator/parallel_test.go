@@ -0,0 +1,98 @@
+package simulator
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestQuotaRatio(t *testing.T) {
+	tests := []struct {
+		quota, period string
+		want          float64
+		ok            bool
+	}{
+		{"150000", "100000", 1.5, true},
+		{"100000", "100000", 1, true},
+		{"max", "100000", 0, false},
+		{"-1", "100000", 0, false},
+		{"50000", "0", 0, false},
+		{"", "", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := quotaRatio(tt.quota, tt.period)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("quotaRatio(%q, %q) = %g, %v; want %g, %v", tt.quota, tt.period, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestEffectiveCPUs(t *testing.T) {
+	if cpus := EffectiveCPUs(); cpus < 1 || cpus > runtime.GOMAXPROCS(0) {
+		t.Errorf("EffectiveCPUs() = %d, want 1 to GOMAXPROCS %d", cpus, runtime.GOMAXPROCS(0))
+	}
+}
+
+// TestMaxParallelBlocks checks that a worker waits for a slot once
+// MaxParallel workers hold one.
+func TestMaxParallelBlocks(t *testing.T) {
+	engine := NewEngine()
+	engine.MaxParallel = 2
+	engine.acquireWorker()
+	engine.acquireWorker()
+
+	acquired := make(chan struct{})
+	go func() {
+		engine.acquireWorker()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("a third worker ran with MaxParallel 2")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	engine.releaseWorker()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("a worker still waits after a slot was released")
+	}
+}
+
+// benchmarkParallelism runs a flop calculation with the given workers and
+// MaxParallel. Compare runs across -cpu values to see the cost of more
+// workers than CPUs.
+func benchmarkParallelism(b *testing.B, workers, maxParallel int) {
+	engine := NewEngine()
+	engine.MaxParallel = maxParallel
+	params := OddsParams{
+		HoleCards:    mustCards(b, "As Kd"),
+		BoardCards:   mustCards(b, "Qs 7d 2c"),
+		NumOpponents: 3,
+		Simulations:  1000,
+		Workers:      workers,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := engine.Odds(params); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParallelismOneWorker(b *testing.B) {
+	benchmarkParallelism(b, 1, 0)
+}
+
+func BenchmarkParallelismEffectiveCPUs(b *testing.B) {
+	benchmarkParallelism(b, EffectiveCPUs(), 0)
+}
+
+func BenchmarkParallelismOversubscribed(b *testing.B) {
+	benchmarkParallelism(b, 16, 0)
+}
+
+func BenchmarkParallelismOversubscribedCapped(b *testing.B) {
+	benchmarkParallelism(b, 16, EffectiveCPUs())
+}
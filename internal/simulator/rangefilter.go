@@ -0,0 +1,100 @@
+package simulator
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// RangeFilter describes which combos of a range are still in the hand on
+// the known board, such as "second pair or better, or a strong draw".
+// Combos that would have folded to earlier action are dropped before any
+// are sampled.
+type RangeFilter struct {
+	// MinRank is the weakest hand category kept, made by the combo with
+	// the known board. A hand the board makes alone, such as a pair on
+	// the board, counts as HighCard.
+	MinRank evaluator.HandRank
+	// MinPair, when positive and MinRank is OnePair, keeps only pairs
+	// ranked at least this high on the board: 1 is top pair or an
+	// overpair, 2 second pair, and so on. A pair's position is one more
+	// than the number of distinct board ranks above it.
+	MinPair int
+	// KeepDraws also keeps combos below the threshold that hold a flush
+	// draw or an open-ended straight draw.
+	KeepDraws bool
+}
+
+// Apply returns the combos of r that pass the filter on board. Combos
+// sharing a card with the board are dropped too.
+func (f RangeFilter) Apply(r handrange.Range, board []*card.Card) handrange.Range {
+	boardRank := evaluator.EvaluateHand(board).Rank
+
+	kept := make(handrange.Range, 0, len(r))
+	for _, combo := range r.Without(board) {
+		hole := combo.Cards[:]
+		if f.keeps(hole, board, boardRank) {
+			kept = append(kept, combo)
+		}
+	}
+	return kept
+}
+
+// keeps reports whether a holding passes the filter on board, where the
+// board alone makes boardRank.
+func (f RangeFilter) keeps(hole, board []*card.Card, boardRank evaluator.HandRank) bool {
+	hand := evaluator.EvaluateWithBoard(hole, board)
+	rank := hand.Rank
+	if rank <= boardRank {
+		rank = evaluator.HighCard
+	}
+
+	switch {
+	case rank > f.MinRank:
+		return true
+	case rank == f.MinRank && (rank != evaluator.OnePair || f.MinPair < 1 || pairPosition(hand.Kickers[0], board) <= f.MinPair):
+		return true
+	case f.KeepDraws && len(board) < 5:
+		for _, draw := range evaluator.DetectDraws(hole, board) {
+			if draw == evaluator.FlushDraw || draw == evaluator.OpenEndedStraightDraw {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pairPosition returns 1 for a pair at or above the top board card, 2 for
+// one below exactly one distinct board rank, and so on.
+func pairPosition(pair int, board []*card.Card) int {
+	var above uint16
+	for _, c := range board {
+		if value := c.RankValue(); value > pair {
+			above |= 1 << uint(value)
+		}
+	}
+	return 1 + bits.OnesCount16(above)
+}
+
+// applyRangeFilter narrows params.OpponentRange with its filter, when one
+// is set, so the rest of the calculation sees only the kept combos.
+func applyRangeFilter(params OddsParams) (OddsParams, error) {
+	if params.OpponentFilter == nil {
+		return params, nil
+	}
+	switch {
+	case params.OpponentRange == nil:
+		return params, newDealError(ErrRangeFilter, "a range filter needs an opponent range")
+	case len(params.BoardCards) < 3:
+		return params, newDealError(ErrRangeFilter, fmt.Sprintf(
+			"a range filter needs at least 3 board cards, got %d", len(params.BoardCards)))
+	}
+	params.OpponentRange = params.OpponentFilter.Apply(params.OpponentRange, params.BoardCards)
+	if len(params.OpponentRange) == 0 {
+		return params, newDealError(ErrEmptyRange, "opponent range has no combos left after the range filter")
+	}
+	return params, nil
+}
@@ -0,0 +1,316 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// RankParams describes candidate hero hands to rank by equity in one spot.
+type RankParams struct {
+	// Hands are the candidate hero hands, two hole cards each. They may
+	// share cards with each other, but not with the board or dead cards.
+	Hands        [][]*card.Card
+	BoardCards   []*card.Card
+	DeadCards    []*card.Card
+	NumOpponents int
+	// Simulations and Workers fall back to the engine defaults when below 1.
+	Simulations int
+	Workers     int
+	// Seed makes the calculation reproducible when set.
+	Seed *int64
+}
+
+// RankedHand is one candidate hand's odds in a ranking.
+type RankedHand struct {
+	// Index is the hand's position in RankParams.Hands.
+	Index     int          `json:"index"`
+	HoleCards []*card.Card `json:"hole_cards"`
+	Win       float64      `json:"win"`
+	Tie       float64      `json:"tie"`
+	Loss      float64      `json:"loss"`
+	// PotShare is the hand's expected share of the pot, as in OddsResult.
+	PotShare float64 `json:"pot_share"`
+	// StandardError is the standard error of PotShare.
+	StandardError float64 `json:"standard_error"`
+	// Lead compares the hand with the one ranked below it, from the same
+	// paired samples. It is nil for the last hand.
+	Lead *EquityComparison `json:"lead,omitempty"`
+}
+
+// RankResult holds the candidate hands, best PotShare first.
+type RankResult struct {
+	Hands       []RankedHand `json:"hands"`
+	Simulations int          `json:"simulations"`
+
+	// shares and products are the mean pot share of each hand and the mean
+	// product of each pair's shares, both by request index, from which
+	// Compare takes the covariance.
+	shares   []float64
+	products [][]float64
+}
+
+// Compare compares the pot share of the hands at indexes a and b of
+// RankParams.Hands. Both played the same simulations, so the variance of
+// their difference subtracts their covariance: for similar hands that is
+// most of it, and the interval is far tighter than CompareEquity's for
+// separate runs of the same size.
+func (r *RankResult) Compare(a, b int) EquityComparison {
+	n := float64(r.Simulations)
+	covariance := func(i, j int) float64 {
+		return r.products[i][j] - r.shares[i]*r.shares[j]
+	}
+	variance := covariance(a, a) + covariance(b, b) - 2*covariance(a, b)
+	return newEquityComparison(r.shares[a]-r.shares[b], math.Sqrt(math.Max(variance, 0)/n))
+}
+
+// RankHands calculates the equity of every candidate hand against the
+// same number of random opponents and ranks them, best first, with each
+// hand's lead over the next.
+//
+// The hands share common random numbers: each simulation shuffles one
+// deck, and every hand deals the board and then the opponents from the
+// same positions of it, replacing any of its own cards there with the
+// next cards past the deal. Each hand's deal is still uniformly random,
+// but hands meet the same runouts and opponents wherever their cards
+// allow, so most of the sampling noise is common to all of them and drops
+// out of their order and differences.
+func (e *Engine) RankHands(params RankParams) (*RankResult, error) {
+	if err := checkRankHands(params); err != nil {
+		return nil, err
+	}
+
+	simulations, workers, err := e.simulationCounts(params.Simulations, params.Workers)
+	if err != nil {
+		return nil, err
+	}
+
+	known := append(append([]*card.Card{}, params.BoardCards...), params.DeadCards...)
+	shares := splitSimulations(simulations, workers)
+	tallies := make([]rankTallies, len(shares))
+	offsets := shareOffsets(shares, 0)
+
+	var wg sync.WaitGroup
+	for i, sims := range shares {
+		wg.Add(1)
+
+		rng := e.workerRand(params.Seed, offsets[i])
+		go func(i, sims int) {
+			defer wg.Done()
+			e.acquireWorker()
+			defer e.releaseWorker()
+			deck := e.decks.get(known)
+			tallies[i] = runRanking(params, deck, sims, rng)
+		}(i, sims)
+	}
+	wg.Wait()
+
+	count := len(params.Hands)
+	totals := newRankTallies(count)
+	for _, worker := range tallies {
+		for h, tally := range worker.hands {
+			totals.hands[h].wins += tally.wins
+			totals.hands[h].ties += tally.ties
+			totals.hands[h].splitShares += tally.splitShares
+			totals.hands[h].splitSquares += tally.splitSquares
+		}
+		for i, product := range worker.products {
+			totals.products[i] += product
+		}
+	}
+
+	n := float64(simulations)
+	result := &RankResult{
+		Hands:       make([]RankedHand, count),
+		Simulations: simulations,
+		shares:      make([]float64, count),
+		products:    make([][]float64, count),
+	}
+	hands := result.Hands
+	for h, tally := range totals.hands {
+		win := float64(tally.wins) / n
+		share := win + tally.splitShares/n
+		variance := math.Max(win+tally.splitSquares/n-share*share, 0)
+		hands[h] = RankedHand{
+			Index:         h,
+			HoleCards:     params.Hands[h],
+			Win:           win,
+			Tie:           float64(tally.ties) / n,
+			Loss:          float64(simulations-tally.wins-tally.ties) / n,
+			PotShare:      share,
+			StandardError: math.Sqrt(variance / n),
+		}
+		result.shares[h] = share
+
+		// The matrix is symmetric; runRanking sums each pair once.
+		result.products[h] = make([]float64, count)
+		result.products[h][h] = win + tally.splitSquares/n
+		for other := 0; other < h; other++ {
+			product := totals.products[other*count+h] / n
+			result.products[h][other] = product
+			result.products[other][h] = product
+		}
+	}
+	sort.SliceStable(hands, func(a, b int) bool {
+		return hands[a].PotShare > hands[b].PotShare
+	})
+	for i := 0; i+1 < len(hands); i++ {
+		lead := result.Compare(hands[i].Index, hands[i+1].Index)
+		hands[i].Lead = &lead
+	}
+
+	return result, nil
+}
+
+// rankTally counts one candidate hand's showdowns, with split pots summed
+// as in workerResult.
+type rankTally struct {
+	wins         int
+	ties         int
+	splitShares  float64
+	splitSquares float64
+}
+
+// rankTallies holds every candidate hand's tally and, for each pair of
+// hands a < b, the sum over simulations of the product of their pot
+// shares at products[a*len(hands)+b].
+type rankTallies struct {
+	hands    []rankTally
+	products []float64
+}
+
+// newRankTallies returns empty tallies for count hands.
+func newRankTallies(count int) rankTallies {
+	return rankTallies{
+		hands:    make([]rankTally, count),
+		products: make([]float64, count*count),
+	}
+}
+
+// runRanking performs ranking simulations for one worker, evaluating every
+// candidate hand on each sampled deal, and returns their tallies.
+func runRanking(params RankParams, deck []uint8, simulations int, streams *simulationRand) rankTallies {
+	count := len(params.Hands)
+	tallies := newRankTallies(count)
+	// shares holds each hand's pot share in the current simulation.
+	shares := make([]float64, count)
+
+	// own marks each hand's hole cards, which it skips in the shared deck.
+	own := make([]uint64, len(params.Hands))
+	for h, hole := range params.Hands {
+		for _, c := range hole {
+			own[h] |= 1 << deckIndex(c)
+		}
+	}
+
+	missing := 5 - len(params.BoardCards)
+	needed := missing + 2*params.NumOpponents
+	dealt := make([]*card.Card, 0, needed)
+	board := make([]*card.Card, 5)
+	copy(board, params.BoardCards)
+	hand := make([]*card.Card, 0, 7)
+	base := streams.baseDeck(deck)
+
+	for i := 0; i < simulations; i++ {
+		rng, reset := streams.advance()
+		if reset {
+			copy(deck, base)
+		}
+		shuffleDeck(deck, rng)
+
+		for h, hole := range params.Hands {
+			// A hand's own cards in the deal are replaced, in order, by
+			// the next cards past it that the hand does not hold.
+			dealt = dealt[:0]
+			spare := needed
+			for _, index := range deck[:needed] {
+				for own[h]&(1<<index) != 0 {
+					index = deck[spare]
+					spare++
+				}
+				dealt = append(dealt, deckCards[index])
+			}
+			copy(board[len(params.BoardCards):], dealt[:missing])
+			opponents := dealt[missing:]
+
+			hand = append(append(hand[:0], hole...), board...)
+			hero := evaluator.EvaluateHand(hand)
+
+			beaten, tied := false, 0
+			for o := 0; o < len(opponents) && !beaten; o += 2 {
+				hand = append(append(hand[:0], opponents[o:o+2]...), board...)
+				switch hero.Compare(evaluator.EvaluateHand(hand)) {
+				case -1:
+					beaten = true
+				case 0:
+					tied++
+				}
+			}
+
+			tally := &tallies.hands[h]
+			switch {
+			case beaten:
+				shares[h] = 0
+			case tied == 0:
+				tally.wins++
+				shares[h] = 1
+			default:
+				players := float64(tied + 1)
+				tally.ties++
+				tally.splitShares += 1 / players
+				tally.splitSquares += 1 / (players * players)
+				shares[h] = 1 / players
+			}
+		}
+
+		for a := 0; a < count; a++ {
+			if shares[a] == 0 {
+				continue
+			}
+			for b := a + 1; b < count; b++ {
+				tallies.products[a*count+b] += shares[a] * shares[b]
+			}
+		}
+	}
+	return tallies
+}
+
+// checkRankHands verifies the candidate hands and that the deck can deal
+// every opponent whichever hand is played.
+func checkRankHands(params RankParams) error {
+	if len(params.Hands) < 2 {
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"a ranking needs at least 2 hands, got %d", len(params.Hands)))
+	}
+	if len(params.BoardCards) > 5 {
+		return newDealError(ErrInvalidCards, fmt.Sprintf(
+			"board cannot have more than 5 cards, got %d", len(params.BoardCards)))
+	}
+	if params.NumOpponents < 1 {
+		return newDealError(ErrOpponentHands, fmt.Sprintf(
+			"a ranking needs at least 1 opponent, got %d", params.NumOpponents))
+	}
+
+	known := append(append([]*card.Card{}, params.BoardCards...), params.DeadCards...)
+	for h, hole := range params.Hands {
+		if len(hole) != 2 {
+			return newDealError(ErrInvalidCards, fmt.Sprintf(
+				"hand %d must have exactly 2 hole cards, got %d", h+1, len(hole)))
+		}
+		if err := card.CheckUnique(append(append([]*card.Card{}, hole...), known...)); err != nil {
+			return err
+		}
+	}
+
+	remaining := len(deckCards) - len(known) - 2 - (5 - len(params.BoardCards))
+	if needed := 2 * params.NumOpponents; needed > max(remaining, 0) {
+		return newDealError(ErrInsufficientCards, fmt.Sprintf(
+			"requested %d opponents requires %d cards but only %d remain",
+			params.NumOpponents, needed, max(remaining, 0)))
+	}
+	return nil
+}
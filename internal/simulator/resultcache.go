@@ -0,0 +1,95 @@
+package simulator
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// maxCachedResults bounds how many results a cache keeps.
+const maxCachedResults = 1024
+
+// resultCache keeps the results of deterministic calculations, seeded or
+// exact, keyed by their parameters, so repeating one returns the stored
+// result instead of recomputing it. It is safe for concurrent use.
+type resultCache struct {
+	mu      sync.RWMutex
+	results map[string]*OddsResult
+}
+
+// newResultCache creates an empty result cache.
+func newResultCache() *resultCache {
+	return &resultCache{results: make(map[string]*OddsResult)}
+}
+
+// get returns a copy of the cached result for key, marked Cached. A nil
+// cache never holds a result.
+func (c *resultCache) get(key string) (*OddsResult, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	result, ok := c.results[key]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	cached := *result
+	cached.Cached = true
+	return &cached, true
+}
+
+// put stores result under key.
+func (c *resultCache) put(key string, result *OddsResult) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	if len(c.results) >= maxCachedResults {
+		c.results = make(map[string]*OddsResult)
+	}
+	c.results[key] = result
+	c.mu.Unlock()
+}
+
+// cacheKey describes every parameter that affects a calculation, with
+// simulations and workers after defaults are applied. mode separates
+// Odds from ExactOdds.
+func (p OddsParams) cacheKey(mode string, simulations, workers int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%d|%d|%d|%d|%t|%d|%d|%g|%d|%d|%t|%d|%g|", mode, p.NumOpponents, p.FoldedPlayers,
+		p.boards(), p.TopLosingHands, p.WeightedRange, simulations, workers, p.TargetMargin, p.chunkSize(), p.HoleUse,
+		p.IgnoreKickers, p.Perspective, p.FoldFrequency)
+	if p.Seed != nil {
+		fmt.Fprintf(&b, "%d", *p.Seed)
+	}
+
+	writeCards := func(cards []*card.Card) {
+		b.WriteByte('|')
+		for _, c := range cards {
+			b.WriteString(c.String())
+		}
+	}
+	writeCards(p.HoleCards)
+	writeCards(p.BoardCards)
+	writeCards(p.DeadCards)
+	writeCards(p.BurnedCards)
+	writeCards(p.KnownOutOfPlay)
+	for _, hand := range p.OpponentHoleCards {
+		writeCards(hand)
+	}
+
+	for _, r := range []handrange.Range{p.HeroRange, p.OpponentRange} {
+		b.WriteByte('|')
+		for _, combo := range r {
+			fmt.Fprintf(&b, "%s%s:%g,", combo.Cards[0], combo.Cards[1], combo.Weight)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package simulator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
+)
+
+// TestCacheKeyCoversEveryField changes each exported OddsParams field in
+// turn and requires the cache key to change with it, so a field added
+// without a place in the key fails here rather than returning another
+// calculation's cached result.
+func TestCacheKeyCoversEveryField(t *testing.T) {
+	opponentRange, err := handrange.Parse("AA, KK, 72o, Q3o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	heroRange, err := handrange.Parse("AKs, QQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every field is set, so zeroing a pointer or dropping the first
+	// element of a slice is a change.
+	base := OddsParams{
+		HoleCards:         mustCards(t, "As Ks"),
+		BoardCards:        mustCards(t, "2c 7d 9h Js"),
+		NumOpponents:      3,
+		OpponentHoleCards: [][]*card.Card{mustCards(t, "Qh Qd"), mustCards(t, "Th")},
+		FoldedPlayers:     1,
+		OpponentRange:     opponentRange,
+		// Keeps every combo but Q3o.
+		OpponentFilter: &RangeFilter{MinRank: evaluator.OnePair},
+		HeroRange:      heroRange,
+		WeightedRange:  true,
+		Boards:         2,
+		DeadCards:      mustCards(t, "3s 4s"),
+		BurnedCards:    mustCards(t, "5s 6s"),
+		KnownOutOfPlay: mustCards(t, "8s 9s"),
+		TopLosingHands: 5,
+		Simulations:    1000,
+		Workers:        2,
+		TargetMargin:   0.01,
+		ChunkSize:      500,
+		Seed:           seed(7),
+		HoleUse:        2,
+		IgnoreKickers:  true,
+		Perspective:    1,
+		FoldFrequency:  0.5,
+	}
+
+	// key derives the key as OddsContext does: after the range filter,
+	// with the simulation and worker counts passed separately.
+	key := func(p OddsParams) string {
+		t.Helper()
+		p, err := applyRangeFilter(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p.cacheKey("odds", p.Simulations, p.Workers)
+	}
+	baseKey := key(base)
+
+	typ := reflect.TypeOf(base)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		changed := base
+		v := reflect.ValueOf(&changed).Elem().Field(i)
+		if v.IsZero() {
+			t.Errorf("%s is unset in the base params", field.Name)
+			continue
+		}
+		switch v.Kind() {
+		case reflect.Int:
+			v.SetInt(v.Int() + 1)
+		case reflect.Float64:
+			v.SetFloat(v.Float() / 2)
+		case reflect.Bool:
+			v.SetBool(!v.Bool())
+		case reflect.Slice:
+			v.Set(v.Slice(1, v.Len()))
+		case reflect.Ptr:
+			v.Set(reflect.Zero(v.Type()))
+		default:
+			t.Errorf("%s has kind %s, which the test cannot change", field.Name, v.Kind())
+			continue
+		}
+
+		if key(changed) == baseKey {
+			t.Errorf("changing %s leaves the cache key unchanged", field.Name)
+		}
+	}
+}
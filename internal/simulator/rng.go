@@ -0,0 +1,81 @@
+package simulator
+
+import "math/rand"
+
+// splitMix64 is a small, fast rand.Source64. Seeded calculations reset it
+// for every simulation, which would be too costly with a math/rand source.
+type splitMix64 struct {
+	state uint64
+}
+
+// Seed implements rand.Source.
+func (s *splitMix64) Seed(seed int64) {
+	s.state = uint64(seed)
+}
+
+// Uint64 implements rand.Source64.
+func (s *splitMix64) Uint64() uint64 {
+	s.state += 0x9E3779B97F4A7C15
+	return mix64(s.state)
+}
+
+// Int63 implements rand.Source.
+func (s *splitMix64) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// mix64 is the SplitMix64 finalizer, a bijective 64-bit hash.
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// simulationRand supplies the random source for each simulation a worker
+// runs. Seeded calculations give every simulation its own stream derived
+// from the seed and the simulation's global index, so results do not
+// depend on how simulations are split between workers.
+type simulationRand struct {
+	rng *rand.Rand
+	// stream is set for seeded calculations and reset per simulation.
+	stream *splitMix64
+	seed   uint64
+	next   int
+}
+
+// advance returns the source for the worker's next simulation. It reports
+// true when the source was reset, in which case the caller must restore
+// its deck to the base order so the deal depends only on the stream.
+func (r *simulationRand) advance() (*rand.Rand, bool) {
+	if r.stream == nil {
+		return r.rng, false
+	}
+	r.stream.state = mix64(r.seed ^ mix64(uint64(r.next)+1))
+	r.next++
+	return r.rng, true
+}
+
+// baseDeck returns a copy of deck to restore before each seeded
+// simulation, or nil when the calculation is unseeded.
+func (r *simulationRand) baseDeck(deck []uint8) []uint8 {
+	if r.stream == nil {
+		return nil
+	}
+	return append([]uint8(nil), deck...)
+}
+
+// workerRand returns the random source for a worker whose first simulation
+// has global index first. Unseeded workers share no state and draw from
+// e.NewRand.
+func (e *Engine) workerRand(seed *int64, first int) *simulationRand {
+	if seed == nil {
+		return &simulationRand{rng: e.NewRand()}
+	}
+	stream := &splitMix64{}
+	return &simulationRand{
+		rng:    rand.New(stream),
+		stream: stream,
+		seed:   uint64(*seed),
+		next:   first,
+	}
+}
@@ -2,141 +2,492 @@
 package simulator
 
 import (
+	"context"
 	"math/rand"
-	"sync"
-	"time"
+	"sort"
 
 	"github.com/KyleKDang/poker-odds-engine/internal/card"
 	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+	"github.com/KyleKDang/poker-odds-engine/internal/handrange"
 )
 
-// OddsResult contains win/tie/loss probabilities.
+// OddsResult contains win/tie/loss probabilities. With multiple boards
+// they are averaged over every board dealt.
 type OddsResult struct {
 	Win  float64 `json:"win"`
 	Tie  float64 `json:"tie"`
 	Loss float64 `json:"loss"`
+	// PotShare is the hero's expected share of the pot. Each board carries
+	// an equal part of the pot: all of it for a win, 1/k of it when the
+	// hero is one of k players tied for the best hand, and none for a loss.
+	PotShare float64 `json:"pot_share"`
+	// Simulations is the number of simulations run.
+	Simulations int `json:"simulations"`
+	// Showdowns is the number of hands compared at showdown, one per board
+	// in every simulation.
+	Showdowns int `json:"showdowns"`
+	// Classes is the number of suit-distinct runouts ExactOdds evaluated
+	// to cover all Showdowns. It is zero for simulated results.
+	Classes int `json:"classes,omitempty"`
+	// StandardError is the analytic standard error of Win.
+	StandardError float64 `json:"standard_error"`
+	// Margin is the half-width of the 95% confidence interval of PotShare,
+	// reported when OddsParams.TargetMargin is set.
+	Margin float64 `json:"margin,omitempty"`
+	// WorkerWinVariance and WorkerWinStdDev are the sample variance and
+	// standard deviation of the win rates of the individual workers, each
+	// an independent batch. They are zero with fewer than two workers.
+	WorkerWinVariance float64 `json:"worker_win_variance"`
+	WorkerWinStdDev   float64 `json:"worker_win_std_dev"`
+	// WinningHandDistribution maps hand names to how often the best hand
+	// at showdown, whoever held it, was of that category.
+	WinningHandDistribution map[string]float64 `json:"winning_hand_distribution"`
+	// HeadToHead holds the hero's results against each fixed opponent
+	// hand alone, in the order of OddsParams.OpponentHoleCards.
+	HeadToHead []HeadToHead `json:"head_to_head,omitempty"`
+	// TopLosingHands lists the opponent holdings that most often beat the
+	// hero, when OddsParams.TopLosingHands is set.
+	TopLosingHands []HoldingFrequency `json:"top_losing_hands,omitempty"`
+	// Diagnostics records the deck state the calculation started from.
+	Diagnostics Diagnostics `json:"diagnostics"`
+	// FoldEquity is the share of the pot won when the field folds, which
+	// is OddsParams.FoldFrequency, and ShowdownPotShare the hero's pot
+	// share when called. Win and PotShare include the folds; both fields
+	// are zero without a fold frequency.
+	FoldEquity       float64 `json:"fold_equity,omitempty"`
+	ShowdownPotShare float64 `json:"showdown_pot_share,omitempty"`
+	// shareSquares is the mean squared pot share of a showdown, from which
+	// PotShareStandardError takes the variance.
+	shareSquares float64
+	// Cached is set when the result was served from the engine's cache of
+	// seeded and exact calculations instead of being computed.
+	Cached bool `json:"cached"`
 }
 
+// HoldingFrequency is how often a specific opponent holding occurred.
+type HoldingFrequency struct {
+	HoleCards []*card.Card `json:"hole_cards"`
+	// Frequency is the share of showdowns.
+	Frequency float64 `json:"frequency"`
+}
+
+// Diagnostics describes the cards removed from play before dealing.
+type Diagnostics struct {
+	DeadCards   []*card.Card `json:"dead_cards,omitempty"`
+	BurnedCards []*card.Card `json:"burned_cards,omitempty"`
+	// KnownOutOfPlay echoes OddsParams.KnownOutOfPlay.
+	KnownOutOfPlay []*card.Card `json:"known_out_of_play,omitempty"`
+	// DeckSize is the number of cards left to deal from.
+	DeckSize int `json:"deck_size"`
+}
+
+// HeadToHead contains win/tie/loss probabilities against one opponent.
+type HeadToHead struct {
+	Win  float64 `json:"win"`
+	Tie  float64 `json:"tie"`
+	Loss float64 `json:"loss"`
+}
+
+// defaultEngine backs the package-level convenience functions.
+var defaultEngine = NewEngine()
+
 // CalculateOdds runs Monte Carlo simulation to calculate poker odds.
-func CalculateOdds(holeCards, boardCards []*card.Card, numOpponents, simulations, workers int) *OddsResult {
-	if workers < 1 {
-		workers = 4
-	}
-	if simulations < 1 {
-		simulations = 10000
-	}
+// It is a thin wrapper around Engine.Odds using the default engine and
+// returns its error for invalid cards or when the deck cannot cover every
+// deal.
+func CalculateOdds(holeCards, boardCards []*card.Card, numOpponents, simulations, workers int) (*OddsResult, error) {
+	return defaultEngine.Odds(OddsParams{
+		HoleCards:    holeCards,
+		BoardCards:   boardCards,
+		NumOpponents: numOpponents,
+		Simulations:  simulations,
+		Workers:      workers,
+	})
+}
 
-	simulationsPerWorker := simulations / workers
-	extraSims := simulations % workers
+// workerResult holds results from a single worker goroutine.
+type workerResult struct {
+	wins        int
+	ties        int
+	simulations int
+	// splitShares sums the hero's part of each tied pot, weight/k for a
+	// k-way tie, and splitSquares sums weight/k² for the variance.
+	splitShares  float64
+	splitSquares float64
+	// showdowns counts hands compared at showdown, one per board dealt.
+	showdowns int
+	// winningHands counts showdowns by the category of the best hand.
+	winningHands map[evaluator.HandRank]int
+	// headToHead counts the hero's results against each fixed opponent.
+	headToHead []headToHeadCount
+	// losingHands counts the opponent holdings that beat the hero, when
+	// OddsParams.TopLosingHands is set.
+	losingHands map[holdingKey]int
+	// hand is scratch space for the cards evaluated at each showdown.
+	hand []*card.Card
+	// holeUse is OddsParams.HoleUse: when positive, hands are made with
+	// exactly that many hole cards.
+	holeUse int
+	// compareMode decides showdowns, by category alone with
+	// OddsParams.IgnoreKickers.
+	compareMode evaluator.CompareMode
+	// perspective is OddsParams.Perspective, and seats is scratch space for
+	// the opponents as that seat sees them.
+	perspective int
+	seats       [][]*card.Card
+	// err reports a deal the worker could not complete.
+	err error
+}
+
+// headToHeadCount tallies the hero's wins and ties against one opponent.
+type headToHeadCount struct {
+	wins int
+	ties int
+}
 
-	var wg sync.WaitGroup
-	results := make(chan workerResult, workers)
+// holdingKey identifies a two-card holding by its deck indexes, lowest
+// first, so the same combo dealt in either order counts once.
+type holdingKey [2]uint8
 
-	// Launch worker goroutines
-	for i := 0; i < workers; i++ {
-		wg.Add(1)
+// newHoldingKey returns the key for a two-card holding.
+func newHoldingKey(hole []*card.Card) holdingKey {
+	a, b := deckIndex(hole[0]), deckIndex(hole[1])
+	if a > b {
+		a, b = b, a
+	}
+	return holdingKey{a, b}
+}
 
-		sims := simulationsPerWorker
-		if i < extraSims {
-			sims++
+// topHoldings returns the n most frequent holdings as shares of showdowns,
+// most frequent first. Equal counts are ordered by deck index so seeded
+// runs always report the same list.
+func topHoldings(counts map[holdingKey]int, n, showdowns int) []HoldingFrequency {
+	keys := make([]holdingKey, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
 		}
+		return keys[i][1] < keys[j][1]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
 
-		go func() {
-			defer wg.Done()
-			result := runSimulations(holeCards, boardCards, numOpponents, sims)
-			results <- result
-		}()
+	top := make([]HoldingFrequency, len(keys))
+	for i, key := range keys {
+		top[i] = HoldingFrequency{
+			HoleCards: []*card.Card{deckCards[key[0]], deckCards[key[1]]},
+			Frequency: float64(counts[key]) / float64(showdowns),
+		}
 	}
+	return top
+}
 
-	// Close channel when all workers finish
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
+// deckCards holds one shared card per deck index, in card.NewDeck order.
+// Workers shuffle and deal small integer indexes into this table so the
+// inner loop swaps bytes instead of pointers.
+var deckCards = card.NewDeck()
 
-	// Aggregate results
-	totalWins := 0
-	totalTies := 0
-	totalSims := 0
+// deckIndex returns the index of c in deckCards.
+func deckIndex(c *card.Card) uint8 {
+	return uint8(card.SuitIndex(c.Suit)*len(card.RankOrder) + c.RankValue())
+}
 
-	for result := range results {
-		totalWins += result.wins
-		totalTies += result.ties
-		totalSims += result.simulations
+// newIndexDeck returns the indexes of every card not in known.
+func newIndexDeck(known []*card.Card) []uint8 {
+	removed := make([]bool, len(deckCards))
+	for _, c := range known {
+		removed[deckIndex(c)] = true
 	}
 
-	totalLosses := totalSims - totalWins - totalTies
+	deck := make([]uint8, 0, len(deckCards))
+	for i := range deckCards {
+		if !removed[i] {
+			deck = append(deck, uint8(i))
+		}
+	}
+	return deck
+}
+
+// maxRangeAttempts bounds rejection sampling of a single range combo.
+const maxRangeAttempts = 1000
+
+// rangeSampler draws opponent combos from a range as deck indexes.
+type rangeSampler struct {
+	combos [][2]uint8
+	// cumulative holds running weight totals when sampling is weighted.
+	cumulative []float64
+}
+
+// newRangeSampler prepares a range for sampling, dropping combos that use
+// known cards. Combos are drawn uniformly unless weighted is set.
+func newRangeSampler(r handrange.Range, known []*card.Card, weighted bool) *rangeSampler {
+	available := r.Without(known)
+	sampler := &rangeSampler{combos: make([][2]uint8, len(available))}
 
-	return &OddsResult{
-		Win: float64(totalWins) / float64(totalSims),
-		Tie: float64(totalTies) / float64(totalSims),
-		Loss: float64(totalLosses) / float64(totalSims),
+	total := 0.0
+	for i, combo := range available {
+		sampler.combos[i] = [2]uint8{deckIndex(combo.Cards[0]), deckIndex(combo.Cards[1])}
+		if weighted {
+			total += combo.Weight
+			sampler.cumulative = append(sampler.cumulative, total)
+		}
 	}
+	return sampler
 }
 
-// workerResult holds results from a single worker goroutine.
-type workerResult struct {
-	wins        int
-	ties        int
-	simulations int
+// sample draws a combo that uses none of the cards marked in used.
+func (s *rangeSampler) sample(rng *rand.Rand, used *[52]bool) ([2]uint8, bool) {
+	for attempt := 0; attempt < maxRangeAttempts; attempt++ {
+		var combo [2]uint8
+		if s.cumulative != nil {
+			total := s.cumulative[len(s.cumulative)-1]
+			combo = s.combos[sort.SearchFloat64s(s.cumulative, rng.Float64()*total)]
+		} else {
+			combo = s.combos[rng.Intn(len(s.combos))]
+		}
+
+		if !used[combo[0]] && !used[combo[1]] {
+			return combo, true
+		}
+	}
+	return [2]uint8{}, false
 }
 
+// cancelCheckInterval is how many simulations a worker runs between
+// checks of its context.
+const cancelCheckInterval = 1024
+
 // runSimulations performs Monte Carlo simulations for one worker.
-func runSimulations(holeCards, boardCards []*card.Card, numOpponents, simulations int) workerResult {
-	known := append(holeCards, boardCards...)
-	deck := card.RemoveCards(card.NewDeck(), known)
+// deck holds the indexes of every card not in known and is shuffled in place.
+// It gives up with ctx.Err() once ctx is done. A non-nil recorder keeps
+// the deals it has room for.
+func runSimulations(ctx context.Context, params OddsParams, deck []uint8, simulations int, streams *simulationRand, recorder *traceRecorder) workerResult {
+	holeCards := params.HoleCards
+	boardCards := params.BoardCards
+	numOpponents := params.NumOpponents
+	boards := params.boards()
+	holeSize := params.holeSize()
+
+	known := params.knownCards()
+
+	var sampler *rangeSampler
+	if params.OpponentRange != nil {
+		sampler = newRangeSampler(params.OpponentRange, known, params.WeightedRange)
+	}
+	var heroSampler *rangeSampler
+	if params.HeroRange != nil {
+		heroSampler = newRangeSampler(params.HeroRange, known, params.WeightedRange)
+	}
 
-	wins := 0
-	ties := 0
+	result := newWorkerResult(params)
+	fixed := params.OpponentHoleCards
 
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	base := streams.baseDeck(deck)
 
 	// Run simulations
 	for i := 0; i < simulations; i++ {
+		if i%cancelCheckInterval == 0 && ctx.Err() != nil {
+			return workerResult{err: ctx.Err()}
+		}
+		rng, reset := streams.advance()
+		if reset {
+			copy(deck, base)
+		}
 		shuffleDeck(deck, rng)
 
-		missingCards := 5 - len(boardCards)
-		fullBoard := make([]*card.Card, len(boardCards))
-		copy(fullBoard, boardCards)
-		fullBoard = append(fullBoard, deck[:missingCards]...)
-
+		// A hero range combo and range opponents are dealt first; used marks
+		// their cards so the board and random holdings are drawn from what
+		// remains, and opponents conflicting with the hero are resampled.
+		var used [52]bool
+		if heroSampler != nil {
+			combo, ok := heroSampler.sample(rng, &used)
+			if !ok {
+				return workerResult{err: ErrRangeConflict}
+			}
+			used[combo[0]], used[combo[1]] = true, true
+			holeCards = []*card.Card{deckCards[combo[0]], deckCards[combo[1]]}
+		}
 		opponentHands := make([][]*card.Card, numOpponents)
-		idx := missingCards
-		for j := 0; j < numOpponents; j++ {
-			opponentHands[j] = []*card.Card{deck[idx], deck[idx+1]}
-			idx += 2
+		for j, hand := range fixed {
+			if len(hand) == holeSize {
+				opponentHands[j] = append([]*card.Card(nil), hand...)
+			}
+		}
+		if sampler != nil {
+			for j := len(fixed); j < numOpponents; j++ {
+				combo, ok := sampler.sample(rng, &used)
+				if !ok {
+					return workerResult{err: ErrRangeConflict}
+				}
+				used[combo[0]], used[combo[1]] = true, true
+				opponentHands[j] = []*card.Card{deckCards[combo[0]], deckCards[combo[1]]}
+			}
 		}
 
-		playerCards := append(holeCards, fullBoard...)
-		playerResult := evaluator.EvaluateHand(playerCards)
+		next := 0
+		draw := func() *card.Card {
+			for used[deck[next]] {
+				next++
+			}
+			next++
+			return deckCards[deck[next-1]]
+		}
 
-		var bestOpponent *evaluator.HandResult
-		for _, oppHole := range opponentHands {
-			oppCards := append(oppHole, fullBoard...)
-			oppResult := evaluator.EvaluateHand(oppCards)
+		fullBoards := make([][]*card.Card, boards)
+		for b := range fullBoards {
+			fullBoard := make([]*card.Card, len(boardCards), 5)
+			copy(fullBoard, boardCards)
+			for len(fullBoard) < 5 {
+				fullBoard = append(fullBoard, draw())
+			}
+			fullBoards[b] = fullBoard
+		}
 
-			if bestOpponent == nil || oppResult.Compare(bestOpponent) > 0 {
-				bestOpponent = oppResult
+		// Partially known opponents get their missing card here, after the
+		// boards, in the same order as fully random opponents.
+		for j := range opponentHands {
+			switch {
+			case opponentHands[j] != nil:
+			case j < len(fixed):
+				opponentHands[j] = []*card.Card{fixed[j][0], draw()}
+			default:
+				opponentHands[j] = make([]*card.Card, holeSize)
+				for k := range opponentHands[j] {
+					opponentHands[j][k] = draw()
+				}
 			}
 		}
 
-		comparison := playerResult.Compare(bestOpponent)
-		if comparison > 0 {
-			wins++
-		} else if comparison == 0 {
-			ties++
+		// Folded players' holdings are the next cards in the deck; they are
+		// out of play for this deal but never reach showdown.
+		for j := 0; j < holeSize*params.FoldedPlayers; j++ {
+			draw()
+		}
+
+		for _, fullBoard := range fullBoards {
+			result.addShowdown(holeCards, opponentHands, fullBoard, 1)
+		}
+		if recorder != nil {
+			recorder.record(&result, i, holeCards, opponentHands, fullBoards)
 		}
 	}
 
-	return workerResult{
-		wins:        wins,
-		ties:        ties,
-		simulations: simulations,
+	result.simulations = simulations
+	return result
+}
+
+// newWorkerResult creates an empty result with the tallies params asks for.
+func newWorkerResult(params OddsParams) workerResult {
+	result := workerResult{
+		winningHands: make(map[evaluator.HandRank]int),
+		headToHead:   make([]headToHeadCount, len(params.OpponentHoleCards)),
+		holeUse:      params.HoleUse,
+		perspective:  params.Perspective,
+	}
+	if params.IgnoreKickers {
+		result.compareMode = evaluator.CompareCategory
+	}
+	if params.TopLosingHands > 0 {
+		result.losingHands = make(map[holdingKey]int)
+	}
+	return result
+}
+
+// evaluate returns a player's best hand on a complete board, using exactly
+// r.holeUse hole cards when it is set.
+//
+// Hold'em hands are copied into r.hand before the board is added.
+// Appending the board to a hole card slice directly would write into its
+// spare capacity, corrupting whatever shares that backing array: another
+// opponent's hand or a caller's slice read by every worker at once.
+func (r *workerResult) evaluate(hole, board []*card.Card) *evaluator.HandResult {
+	if r.holeUse > 0 {
+		// Parameters were checked before dealing, so this cannot fail.
+		result, _ := evaluator.EvaluateConstrained(hole, board, r.holeUse, 5-r.holeUse)
+		return result
+	}
+	r.hand = append(append(r.hand[:0], hole...), board...)
+	return evaluator.EvaluateHand(r.hand)
+}
+
+// addShowdown compares the hero with every opponent on one complete board
+// and counts the outcome weight times. The first len(r.headToHead)
+// opponents are the fixed hands tracked head to head.
+//
+// With a perspective, the chosen opponent swaps seats with the hero, so
+// every tally is from that opponent's point of view.
+func (r *workerResult) addShowdown(holeCards []*card.Card, opponentHands [][]*card.Card, fullBoard []*card.Card, weight int) {
+	if r.perspective > 0 {
+		r.seats = append(r.seats[:0], opponentHands...)
+		seat := r.perspective - 1
+		holeCards, r.seats[seat] = r.seats[seat], holeCards
+		opponentHands = r.seats
+	}
+
+	playerResult := r.evaluate(holeCards, fullBoard)
+
+	var bestOpponent *evaluator.HandResult
+	var bestHole []*card.Card
+	// tied counts the opponents level with the hero. When the hero ties
+	// the best opponent, nobody beats the hero, so they are exactly the
+	// other players sharing the pot.
+	tied := 0
+	for j, oppHole := range opponentHands {
+		oppResult := r.evaluate(oppHole, fullBoard)
+
+		headToHead := playerResult.CompareBy(oppResult, r.compareMode)
+		if headToHead == 0 {
+			tied++
+		}
+		if j < len(r.headToHead) {
+			switch headToHead {
+			case 1:
+				r.headToHead[j].wins += weight
+			case 0:
+				r.headToHead[j].ties += weight
+			}
+		}
+
+		if bestOpponent == nil || oppResult.Compare(bestOpponent) > 0 {
+			bestOpponent = oppResult
+			bestHole = oppHole
+		}
+	}
+
+	// When the board plays, the hero and the best opponent share the same
+	// best five cards, so Compare returns 0 and the deal counts as a tie.
+	// Ignoring kickers, the best opponent still has the best category.
+	comparison := playerResult.CompareBy(bestOpponent, r.compareMode)
+	if comparison > 0 {
+		r.wins += weight
+	} else if comparison == 0 {
+		r.ties += weight
+		players := float64(tied + 1)
+		r.splitShares += float64(weight) / players
+		r.splitSquares += float64(weight) / (players * players)
+	}
+	r.showdowns += weight
+
+	if comparison >= 0 {
+		r.winningHands[playerResult.Rank] += weight
+	} else {
+		r.winningHands[bestOpponent.Rank] += weight
+		if r.losingHands != nil {
+			r.losingHands[newHoldingKey(bestHole)] += weight
+		}
 	}
 }
 
-// shuffleDeck shuffles a deck in place using Fisher-Yates algorithm.
-func shuffleDeck(deck []*card.Card, rng *rand.Rand) {
+// shuffleDeck shuffles a deck of card indexes in place using Fisher-Yates
+// algorithm. It is the index-deck counterpart of card.Shuffle.
+func shuffleDeck(deck []uint8, rng *rand.Rand) {
 	for i := len(deck) - 1; i > 0; i-- {
 		j := rng.Intn(i + 1)
 		deck[i], deck[j] = deck[j], deck[i]
@@ -0,0 +1,287 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// benchmarkOdds runs one single-worker calculation per iteration and
+// reports the simulation rate.
+func benchmarkOdds(b *testing.B, hole, board string, opponents, simulations int) {
+	params := OddsParams{
+		HoleCards:    mustCards(b, hole),
+		BoardCards:   mustCards(b, board),
+		NumOpponents: opponents,
+		Simulations:  simulations,
+		Workers:      1,
+	}
+	engine := NewEngine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		params.Seed = seed(int64(i))
+		if _, err := engine.Odds(params); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(simulations)*float64(b.N)/b.Elapsed().Seconds(), "sims/s")
+}
+
+func BenchmarkOddsHeadsUpPreflop(b *testing.B) {
+	benchmarkOdds(b, "As Ks", "", 1, 2000)
+}
+
+func BenchmarkOddsSixWayFlop(b *testing.B) {
+	benchmarkOdds(b, "As Ks", "Qs 7d 2c", 5, 2000)
+}
+
+// BenchmarkOddsSixWayFlopCached is BenchmarkOddsSixWayFlop with the
+// evaluation cache on, reporting its hit rate.
+func BenchmarkOddsSixWayFlopCached(b *testing.B) {
+	evaluator.SetCache(true)
+	defer evaluator.SetCache(false)
+	hits, misses := evaluator.CacheStats()
+	benchmarkOdds(b, "As Ks", "Qs 7d 2c", 5, 2000)
+	newHits, newMisses := evaluator.CacheStats()
+	hits, misses = newHits-hits, newMisses-misses
+	b.ReportMetric(100*float64(hits)/float64(hits+misses), "%hits")
+}
+
+// The simulator shuffles decks of card indexes; these compare that with
+// shuffling the card pointers the public API uses.
+
+func BenchmarkShuffleIndexDeck(b *testing.B) {
+	deck := newIndexDeck(nil)
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		shuffleDeck(deck, rng)
+	}
+}
+
+func BenchmarkShufflePointerDeck(b *testing.B) {
+	deck := card.NewDeck()
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		card.Shuffle(deck, rng)
+	}
+}
+
+// TestBoardPlays checks boards no hole cards can improve on: a royal
+// flush, broadway without a flush, and quads with an ace kicker. Every
+// showdown must split, whoever holds what.
+func TestBoardPlays(t *testing.T) {
+	for _, board := range []string{"As Ks Qs Js Ts", "Ah Kd Qc Js Th", "9c 9d 9h 9s Ad"} {
+		for _, opponents := range []int{1, 3, 8} {
+			result, err := NewEngine().Odds(OddsParams{
+				HoleCards:    mustCards(t, "2c 3d"),
+				BoardCards:   mustCards(t, board),
+				NumOpponents: opponents,
+				Simulations:  500,
+				Workers:      2,
+				Seed:         seed(1),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Tie != 1 || result.Win != 0 {
+				t.Errorf("%s against %d: win %g, tie %g; want every showdown tied", board, opponents, result.Win, result.Tie)
+			}
+			if want := 1 / float64(opponents+1); math.Abs(result.PotShare-want) > 1e-12 {
+				t.Errorf("%s against %d: pot share %g, want %g", board, opponents, result.PotShare, want)
+			}
+		}
+	}
+}
+
+// TestBoardPlaysExact checks fixed hands on boards that play for the hero:
+// opponents who cannot improve either split, and one who can wins.
+func TestBoardPlaysExact(t *testing.T) {
+	tests := []struct {
+		board, opponent string
+		win, tie        float64
+	}{
+		{"Ah Kh Qh Jh 9h", "8h 2s", 0, 1},
+		{"Ah Kh Qh Jh 9h", "Th 2s", 0, 0},
+		{"5c 6d 7h 8s 9c", "2d 3h", 0, 1},
+		{"5c 6d 7h 8s 9c", "Td 3h", 0, 0},
+		{"Kc Kd Kh 7s 7c", "2d 3h", 0, 1},
+		{"Kc Kd Kh 7s 7c", "Ks 3h", 0, 0},
+	}
+	for _, tc := range tests {
+		result, err := NewEngine().ExactOdds(OddsParams{
+			HoleCards:         mustCards(t, "2c 4d"),
+			BoardCards:        mustCards(t, tc.board),
+			NumOpponents:      1,
+			OpponentHoleCards: [][]*card.Card{mustCards(t, tc.opponent)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Win != tc.win || result.Tie != tc.tie {
+			t.Errorf("%s against %s: win %g, tie %g; want %g, %g", tc.board, tc.opponent, result.Win, result.Tie, tc.win, tc.tie)
+		}
+	}
+}
+
+// TestBoardPlaysNeverWins traces random deals and checks that a hero
+// whose best hand is the board's never wins outright: every opponent's
+// hand is at least the board.
+func TestBoardPlaysNeverWins(t *testing.T) {
+	traces, err := NewEngine().Trace(context.Background(), OddsParams{
+		HoleCards:    mustCards(t, "2c 3d"),
+		NumOpponents: 3,
+		Seed:         seed(3),
+	}, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	played := 0
+	for _, trace := range traces {
+		showdown := trace.Showdowns[0]
+		if showdown.Players[0].Hand.Compare(evaluator.EvaluateHand(showdown.BoardCards)) != 0 {
+			continue
+		}
+		played++
+		if len(showdown.Winners) == 1 && showdown.Winners[0] == 0 {
+			t.Errorf("simulation %d: hero credited a win with the board %v", trace.Simulation, showdown.BoardCards)
+		}
+	}
+	if played == 0 {
+		t.Fatal("no deal where the board plays for the hero")
+	}
+}
+
+func TestCalculateOddsRejectsBadInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		hole      string
+		board     string
+		opponents int
+		want      error
+	}{
+		{"one hole card", "As", "", 1, ErrInvalidCards},
+		{"three hole cards", "As Ks Qs", "", 1, ErrInvalidCards},
+		{"six board cards", "As Ks", "2c 3c 4c 5c 7d 8d", 1, ErrInvalidCards},
+		{"duplicate card", "As Ks", "As 7d 2c", 1, card.ErrDuplicateCard},
+		{"no opponents", "As Ks", "", 0, ErrNoOpponents},
+		{"negative opponents", "As Ks", "", -1, ErrNoOpponents},
+		{"more opponents than cards", "As Ks", "", 24, ErrInsufficientCards},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateOdds(mustCards(t, tt.hole), mustCards(t, tt.board), tt.opponents, 100, 1)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CalculateOdds error = %v, want %v", err, tt.want)
+			}
+			if result != nil {
+				t.Errorf("CalculateOdds returned a result with error %v", err)
+			}
+		})
+	}
+}
+
+// TestShowdownKeepsCallerSlices passes hands with spare capacity, which an
+// append of the board would write into from every worker at once. Run it
+// with -race.
+func TestShowdownKeepsCallerSlices(t *testing.T) {
+	withRoom := func(codes string) []*card.Card {
+		return append(make([]*card.Card, 0, 7), mustCards(t, codes)...)
+	}
+	hole := withRoom("Ah Kh")
+	opponent := withRoom("Qs Qd")
+
+	var first *OddsResult
+	for run := 0; run < 5; run++ {
+		result, err := NewEngine().Odds(OddsParams{
+			HoleCards:         hole,
+			BoardCards:        mustCards(t, "Jh 7h"),
+			NumOpponents:      4,
+			OpponentHoleCards: [][]*card.Card{opponent},
+			Simulations:       1000,
+			Workers:           8,
+			Seed:              seed(42),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first == nil {
+			first = result
+		} else if result.Win != first.Win || result.Tie != first.Tie || result.PotShare != first.PotShare {
+			t.Errorf("run %d: win %g, tie %g, pot share %g; first run %g, %g, %g",
+				run, result.Win, result.Tie, result.PotShare, first.Win, first.Tie, first.PotShare)
+		}
+	}
+
+	for _, hand := range [][]*card.Card{hole, opponent} {
+		for i, c := range hand[:cap(hand)] {
+			if i >= 2 && c != nil {
+				t.Errorf("%v: board card %v written past the hole cards", hand, c)
+			}
+		}
+	}
+}
+
+// TestMultiwaySplitPotShare checks that a tie credits the hero an even
+// share among every tied player, however many others lose.
+func TestMultiwaySplitPotShare(t *testing.T) {
+	tests := []struct {
+		name      string
+		opponents []string
+		want      float64
+	}{
+		{"two-way chop", []string{"Td 4c"}, 1.0 / 2},
+		{"two-way chop with a losing hand", []string{"Td 4c", "9s 8s"}, 1.0 / 2},
+		{"three-way chop", []string{"Td 4c", "Th 5c"}, 1.0 / 3},
+		{"three-way chop with a losing hand", []string{"Td 4c", "9s 8s", "Th 5c"}, 1.0 / 3},
+		{"four-way chop", []string{"Td 4c", "Th 5c", "Ts 6c"}, 1.0 / 4},
+		{"beaten", []string{"Kh Ks"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := OddsParams{
+				HoleCards:    mustCards(t, "Tc 3d"),
+				BoardCards:   mustCards(t, "Ah Kd Qc Js Kc"),
+				NumOpponents: len(tt.opponents),
+			}
+			for _, hand := range tt.opponents {
+				params.OpponentHoleCards = append(params.OpponentHoleCards, mustCards(t, hand))
+			}
+			result, err := NewEngine().ExactOdds(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(result.PotShare-tt.want) > 1e-12 {
+				t.Errorf("pot share %g, want %g", result.PotShare, tt.want)
+			}
+		})
+	}
+}
+
+// TestSplitPotShareAtomic is the royal flush split of TestBoardPlays on
+// the path that tallies more than atomicWorkerThreshold workers.
+func TestSplitPotShareAtomic(t *testing.T) {
+	for _, opponents := range []int{1, 2, 3} {
+		result, err := NewEngine().Odds(OddsParams{
+			HoleCards:    mustCards(t, "2c 3d"),
+			BoardCards:   mustCards(t, "As Ks Qs Js Ts"),
+			NumOpponents: opponents,
+			Simulations:  2 * atomicWorkerThreshold,
+			Workers:      2 * atomicWorkerThreshold,
+			Seed:         seed(1),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := 1 / float64(opponents+1); math.Abs(result.PotShare-want) > 1e-12 {
+			t.Errorf("against %d: pot share %g, want %g", opponents, result.PotShare, want)
+		}
+	}
+}
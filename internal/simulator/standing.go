@@ -0,0 +1,59 @@
+package simulator
+
+import (
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// StandingResult contains how the hero's current hand compares with every
+// possible opponent holding, before any more cards are dealt.
+type StandingResult struct {
+	// Ahead, Tied, and Behind are the fractions of opponent combos the
+	// hero's hand currently beats, ties, and loses to.
+	Ahead  float64 `json:"ahead"`
+	Tied   float64 `json:"tied"`
+	Behind float64 `json:"behind"`
+	// Combos is the number of opponent holdings compared.
+	Combos int `json:"combos"`
+}
+
+// Standing compares the hero's hand on the current board against every
+// two-card holding not containing a known card. Unlike Odds, no board
+// cards are dealt, so draws count for nothing.
+func (e *Engine) Standing(hole, board, dead []*card.Card) *StandingResult {
+	known := make([]*card.Card, 0, len(hole)+len(board)+len(dead))
+	known = append(known, hole...)
+	known = append(known, board...)
+	known = append(known, dead...)
+	deck := newIndexDeck(known)
+
+	hero := evaluator.EvaluateHand(append(append([]*card.Card{}, hole...), board...))
+
+	ahead, tied, behind := 0, 0, 0
+	opponent := make([]*card.Card, 2+len(board))
+	copy(opponent[2:], board)
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			opponent[0], opponent[1] = deckCards[deck[i]], deckCards[deck[j]]
+			switch hero.Compare(evaluator.EvaluateHand(opponent)) {
+			case 1:
+				ahead++
+			case 0:
+				tied++
+			default:
+				behind++
+			}
+		}
+	}
+
+	combos := ahead + tied + behind
+	if combos == 0 {
+		return &StandingResult{}
+	}
+	return &StandingResult{
+		Ahead:  float64(ahead) / float64(combos),
+		Tied:   float64(tied) / float64(combos),
+		Behind: float64(behind) / float64(combos),
+		Combos: combos,
+	}
+}
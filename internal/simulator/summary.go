@@ -0,0 +1,63 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Summary thresholds on the hero's pot share.
+const (
+	// coinFlipMargin is how far from 50% a pot share still reads as a
+	// coin flip.
+	coinFlipMargin = 0.05
+	// lockShare is the pot share above which the hero is all but certain
+	// to win, and below 1-lockShare all but drawing dead.
+	lockShare = 0.995
+	// chopTie is the tie probability from which a split pot is the most
+	// likely outcome.
+	chopTie = 0.5
+)
+
+// Summary phrases the result for people, such as "You're a 3:1 favorite"
+// or "Roughly a coin flip". The odds ratio compares PotShare with the rest
+// of the pot, so ties count as half a win.
+func (r *OddsResult) Summary() string {
+	share := r.PotShare
+	switch {
+	case r.Tie >= chopTie:
+		return "Most likely a chopped pot"
+	case share >= lockShare:
+		return "You're a lock to win"
+	case share <= 1-lockShare:
+		return "You're drawing nearly dead"
+	case math.Abs(share-0.5) < coinFlipMargin:
+		return "Roughly a coin flip"
+	case share > 0.5:
+		if ratio := oddsRatio(share); ratio != "" {
+			return fmt.Sprintf("You're a %s:1 favorite", ratio)
+		}
+		return "You're a slight favorite"
+	default:
+		if ratio := oddsRatio(1 - share); ratio != "" {
+			return fmt.Sprintf("You're a %s:1 underdog", ratio)
+		}
+		return "You're a slight underdog"
+	}
+}
+
+// oddsRatio formats p/(1-p) for p above one half, to the nearest half
+// below 10:1 and the nearest whole number above. It returns "" for ratios
+// that round below 1.5:1, which read better as "slight".
+func oddsRatio(p float64) string {
+	ratio := p / (1 - p)
+	if ratio < 10 {
+		ratio = math.Round(ratio*2) / 2
+	} else {
+		ratio = math.Round(ratio)
+	}
+	if ratio < 1.5 {
+		return ""
+	}
+	return strconv.FormatFloat(ratio, 'f', -1, 64)
+}
@@ -0,0 +1,105 @@
+package simulator
+
+// Streets dealt in a hold'em hand, with the board cards known on each.
+var streets = []struct {
+	name  string
+	cards int
+}{
+	{"preflop", 0},
+	{"flop", 3},
+	{"turn", 4},
+	{"river", 5},
+}
+
+// Outcomes of a hand whose runout and opponent hands are all known.
+const (
+	OutcomeWin  = "win"
+	OutcomeTie  = "tie"
+	OutcomeLoss = "loss"
+)
+
+// StreetEquity is the hero's equity as it stood on one street.
+type StreetEquity struct {
+	Street string  `json:"street"`
+	Win    float64 `json:"win"`
+	Tie    float64 `json:"tie"`
+	Loss   float64 `json:"loss"`
+	// PotShare is the hero's expected share of the pot, as in OddsResult.
+	PotShare float64 `json:"pot_share"`
+	// Exact is set when every runout was enumerated rather than sampled.
+	Exact bool `json:"exact"`
+}
+
+// TimelineResult contains the hero's equity street by street and, once
+// everything is known, how the hand ended.
+type TimelineResult struct {
+	Streets []StreetEquity `json:"streets"`
+	// Outcome is OutcomeWin, OutcomeTie, or OutcomeLoss when the board is
+	// complete and every opponent's hole cards are known, and empty
+	// otherwise.
+	Outcome string `json:"outcome,omitempty"`
+}
+
+// Timeline replays a hand street by street: for preflop and every street
+// the known board reaches, it calculates the hero's equity as if only the
+// cards dealt by then were known. Streets where every opponent's hand is
+// known and the runouts fit within MaxRunouts are enumerated with
+// ExactOdds; the rest are simulated with Odds, sharing Simulations,
+// Workers and Seed. Multiple boards and TargetMargin are not supported.
+func (e *Engine) Timeline(params OddsParams) (*TimelineResult, error) {
+	if err := checkCards(params); err != nil {
+		return nil, err
+	}
+	if err := checkDeckSize(params); err != nil {
+		return nil, err
+	}
+	if params.boards() > 1 {
+		return nil, newDealError(ErrMultipleBoards, "a timeline needs a single board")
+	}
+	params.TargetMargin = 0
+
+	board := params.BoardCards
+	timeline := &TimelineResult{}
+	for _, street := range streets {
+		if street.cards > len(board) {
+			break
+		}
+
+		streetParams := params
+		streetParams.BoardCards = board[:street.cards]
+
+		count, err := e.CountCombinations(streetParams)
+		exact := err == nil && count.Enumerable && count.WithinLimit
+		odds := e.Odds
+		if exact {
+			odds = e.ExactOdds
+		}
+		result, err := odds(streetParams)
+		if err != nil {
+			return nil, err
+		}
+
+		timeline.Streets = append(timeline.Streets, StreetEquity{
+			Street:   street.name,
+			Win:      result.Win,
+			Tie:      result.Tie,
+			Loss:     result.Loss,
+			PotShare: result.PotShare,
+			Exact:    exact,
+		})
+
+		// With the river and every hand known there is a single runout,
+		// so its result is the outcome.
+		if street.cards == 5 && exact {
+			switch {
+			case result.Win == 1:
+				timeline.Outcome = OutcomeWin
+			case result.Tie == 1:
+				timeline.Outcome = OutcomeTie
+			default:
+				timeline.Outcome = OutcomeLoss
+			}
+		}
+	}
+	return timeline, nil
+}
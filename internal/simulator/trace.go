@@ -0,0 +1,104 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/card"
+	"github.com/KyleKDang/poker-odds-engine/internal/evaluator"
+)
+
+// TracedShowdown is one board of a traced simulation: the complete board,
+// every player's cards and best hand, and who won. Players holds the hero
+// first and then each opponent in order.
+type TracedShowdown struct {
+	BoardCards []*card.Card
+	Players    []DealtPlayer
+	// Winners holds the indexes into Players of the best hands, more than
+	// one when they split the pot.
+	Winners []int
+}
+
+// TracedSimulation is one simulated deal, with a showdown per board.
+type TracedSimulation struct {
+	// Simulation is the deal's position in the seeded run, from 0.
+	Simulation int
+	Showdowns  []TracedShowdown
+}
+
+// traceRecorder collects the first limit simulations a worker runs.
+type traceRecorder struct {
+	limit  int
+	traces []TracedSimulation
+}
+
+// record adds simulation i unless the recorder is full. Hands are
+// evaluated as r tallies them, honouring HoleUse and IgnoreKickers, but
+// players keep their seats whatever the perspective.
+func (t *traceRecorder) record(r *workerResult, i int, holeCards []*card.Card, opponentHands [][]*card.Card, fullBoards [][]*card.Card) {
+	if len(t.traces) >= t.limit {
+		return
+	}
+
+	seats := append([][]*card.Card{holeCards}, opponentHands...)
+	trace := TracedSimulation{Simulation: i, Showdowns: make([]TracedShowdown, len(fullBoards))}
+	for b, fullBoard := range fullBoards {
+		showdown := TracedShowdown{BoardCards: fullBoard, Players: make([]DealtPlayer, len(seats))}
+
+		var best *evaluator.HandResult
+		for p, hole := range seats {
+			hand := r.evaluate(hole, fullBoard)
+			showdown.Players[p] = DealtPlayer{HoleCards: hole, Hand: hand}
+
+			comparison := 1
+			if best != nil {
+				comparison = hand.CompareBy(best, r.compareMode)
+			}
+			if comparison > 0 {
+				best = hand
+				showdown.Winners = showdown.Winners[:0]
+			}
+			if comparison >= 0 {
+				showdown.Winners = append(showdown.Winners, p)
+			}
+		}
+		trace.Showdowns[b] = showdown
+	}
+	t.traces = append(t.traces, trace)
+}
+
+// Trace replays the first n simulations of an odds calculation and returns
+// every deal in full, for checking what the simulator plays. Simulations
+// draw from the same streams as Odds, so with a seed they are the first n
+// deals of the seeded calculation however its workers split it.
+// Simulations, Workers and TargetMargin are ignored, and folded players'
+// cards are dealt but not shown.
+func (e *Engine) Trace(ctx context.Context, params OddsParams, n int) ([]TracedSimulation, error) {
+	if n < 1 {
+		return nil, newDealError(ErrNoSimulations, fmt.Sprintf(
+			"a trace needs at least 1 simulation, got %d", n))
+	}
+	if err := checkCards(params); err != nil {
+		return nil, err
+	}
+	params, err := applyRangeFilter(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeckSize(params); err != nil {
+		return nil, err
+	}
+	if err := checkFoldFrequency(params); err != nil {
+		return nil, err
+	}
+
+	recorder := &traceRecorder{limit: n}
+	e.acquireWorker()
+	defer e.releaseWorker()
+	deck := e.decks.get(params.knownCards())
+	result := runSimulations(ctx, params, deck, n, e.workerRand(params.Seed, 0), recorder)
+	if result.err != nil {
+		return nil, result.err
+	}
+	return recorder.traces, nil
+}
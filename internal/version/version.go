@@ -0,0 +1,22 @@
+// Package version holds build information set at link time.
+//
+// Build with, for example:
+//
+//	go build -ldflags "-X github.com/KyleKDang/poker-odds-engine/internal/version.Version=v1.2.0 \
+//		-X github.com/KyleKDang/poker-odds-engine/internal/version.Commit=$(git rev-parse --short HEAD)"
+package version
+
+import "runtime"
+
+// Version and Commit are replaced with -ldflags "-X" at build time.
+var (
+	// Version is the release version of the build.
+	Version = "dev"
+	// Commit is the git commit the build was made from.
+	Commit = "unknown"
+)
+
+// GoVersion returns the Go runtime version the binary was built with.
+func GoVersion() string {
+	return runtime.Version()
+}
@@ -0,0 +1,141 @@
+// Package client provides a typed Go client for the poker odds engine HTTP API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+)
+
+// DefaultTimeout bounds each request made by a Client created with New.
+const DefaultTimeout = 30 * time.Second
+
+// Client calls the poker odds engine endpoints.
+type Client struct {
+	// BaseURL is the server address, e.g. "http://localhost:8001".
+	BaseURL string
+	// HTTPClient performs the requests.
+	HTTPClient *http.Client
+}
+
+// APIError is returned when the server responds with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	// Code is the server's error code, e.g. models.CodeInvalidCard.
+	// It is empty when the response carried no code.
+	Code    string
+	Message string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("poker odds engine: %d %s (%s): %s",
+			e.StatusCode, http.StatusText(e.StatusCode), e.Code, e.Message)
+	}
+	return fmt.Sprintf("poker odds engine: %d %s: %s",
+		e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// New creates a Client for the server at baseURL.
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Health checks that the server is up.
+func (c *Client) Health(ctx context.Context) (*models.HealthResponse, error) {
+	var resp models.HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Evaluate evaluates the best poker hand from the given cards.
+func (c *Client) Evaluate(ctx context.Context, req models.EvaluateRequest) (*models.EvaluateResponse, error) {
+	var resp models.EvaluateResponse
+	if err := c.do(ctx, http.MethodPost, "/evaluate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Odds calculates winning odds using Monte Carlo simulation.
+func (c *Client) Odds(ctx context.Context, req models.OddsRequest) (*models.OddsResponse, error) {
+	var resp models.OddsResponse
+	if err := c.do(ctx, http.MethodPost, "/odds", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return decodeError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// decodeError converts an error response into an APIError.
+func decodeError(resp *http.Response) error {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		apiErr.Message = err.Error()
+		return apiErr
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && errResp.Error != "" {
+		apiErr.Message = errResp.Error
+		apiErr.Code = errResp.Code
+	} else {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	return apiErr
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/KyleKDang/poker-odds-engine/internal/api"
+	"github.com/KyleKDang/poker-odds-engine/pkg/models"
+	"github.com/gin-gonic/gin"
+)
+
+// newServer starts the real API router and returns a client for it.
+func newServer(t *testing.T) *Client {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	srv := httptest.NewServer(api.SetupRouter())
+	t.Cleanup(srv.Close)
+	return New(srv.URL + "/")
+}
+
+func TestEvaluate(t *testing.T) {
+	c := newServer(t)
+	resp, err := c.Evaluate(context.Background(), models.EvaluateRequest{
+		HoleCards:  []string{"AS", "KS"},
+		BoardCards: []string{"QS", "JS", "TS"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Hand != "Royal Flush" {
+		t.Errorf("Hand = %q, want Royal Flush", resp.Hand)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	c := newServer(t)
+	_, err := c.Evaluate(context.Background(), models.EvaluateRequest{
+		HoleCards:  []string{"AS", "XX"},
+		BoardCards: []string{"QS", "JS", "TS"},
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want an *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != models.CodeInvalidCard || apiErr.Message == "" {
+		t.Errorf("got %d %q %q, want 400 %q with a message", apiErr.StatusCode, apiErr.Code, apiErr.Message, models.CodeInvalidCard)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	// A million simulations take far longer than the deadlines below.
+	req := models.OddsRequest{HoleCards: []string{"AS", "AH"}, NumOpponents: 9, Simulations: 1000000}
+
+	t.Run("context", func(t *testing.T) {
+		c := newServer(t)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		_, err := c.Odds(ctx, req)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("http client", func(t *testing.T) {
+		c := newServer(t)
+		c.HTTPClient.Timeout = time.Millisecond
+
+		_, err := c.Odds(context.Background(), req)
+		var netErr net.Error
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("err = %v, want a timeout", err)
+		}
+	})
+}
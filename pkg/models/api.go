@@ -1,35 +1,658 @@
 // Package models defines API request and response structures.
 package models
 
-// EvaluateRequest contains cards to evaluate.
+import "math/big"
+
+// HealthResponse contains server health status.
+type HealthResponse struct {
+	Status  string `json:"status"`
+	Service string `json:"service"`
+	// Version, Commit, and GoVersion identify the running build.
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	GoVersion string `json:"go_version"`
+}
+
+// EvaluateRequest contains cards to evaluate, either split into hole and
+// board cards or as a single combined Cards list.
 type EvaluateRequest struct {
-	HoleCards  []string `json:"hole_cards" binding:"required"`
-	BoardCards []string `json:"board_cards" binding:"required"`
+	HoleCards  []string `json:"hole_cards"`
+	BoardCards []string `json:"board_cards,omitempty"`
+	Cards      []string `json:"cards,omitempty"`
+	// CardIDs gives the cards as integer IDs 0-51 instead of Cards.
+	CardIDs []int `json:"card_ids,omitempty"`
+	// Locale selects the language of the returned hand name (default "en").
+	Locale string `json:"locale,omitempty"`
+	// Variant selects the ranking rules: "holdem" (default) or "badugi".
+	Variant string `json:"variant,omitempty"`
+	// Exclude lists hand ranks (1-10) to leave out when finding a fallback
+	// hand, for hold'em only.
+	Exclude []int `json:"exclude,omitempty" binding:"omitempty,dive,min=1,max=10"`
+	// Labels overrides returned hand names, keyed by the default English
+	// name, e.g. {"Four of a Kind": "Quads"}. Other ranks keep the Locale
+	// name. Badugi hands are not relabeled.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Describe adds an English description of the hand with its ranking
+	// cards, such as "Pair of Kings with Ace kicker", for hold'em and Omaha.
+	Describe bool `json:"describe,omitempty"`
 }
 
 // EvaluateResponse contains the evaluated hand result.
 type EvaluateResponse struct {
 	Hand string `json:"hand"`
 	Rank int    `json:"rank"`
+	// Description names the hand with its ranking cards, when requested.
+	Description string `json:"description,omitempty"`
+	// PlaysBoard is set when the complete board is the best hand, so the
+	// hole cards do not play and a chop is likely.
+	PlaysBoard bool `json:"plays_board,omitempty"`
+	// Draws lists flush and straight draws while more cards are to come.
+	Draws []string `json:"draws,omitempty"`
+	// Cards lists the cards forming the hand, when the variant reports them.
+	Cards []string `json:"cards,omitempty"`
+	// Classification summarizes the hero's situation as "made hand",
+	// "strong draw", "weak draw", or "air", when hole cards are given.
+	Classification string `json:"classification,omitempty"`
+	// Excluding is the best hand outside the requested Exclude ranks,
+	// omitted when none was requested or every combination is excluded.
+	Excluding *ExcludedHand `json:"excluding,omitempty"`
+	// Low is the best eight-or-better low for the hi-lo variant, omitted
+	// when no low qualifies.
+	Low *LowHand `json:"low,omitempty"`
+}
+
+// LowHand is an ace-to-five low, named by its ranks highest first, such as
+// "8-6-4-2-A".
+type LowHand struct {
+	Hand  string   `json:"hand"`
+	Cards []string `json:"cards"`
+}
+
+// BatchEvaluateRequest contains hands of 1-7 cards each to evaluate, as
+// card codes in Hands or card ID lists in IDs.
+type BatchEvaluateRequest struct {
+	Hands [][]string `json:"hands,omitempty"`
+	// IDs gives each hand as integer card IDs 0-51 separated by whitespace
+	// or commas, such as "51 47 43 39 35", instead of Hands.
+	IDs []string `json:"ids,omitempty"`
+	// Locale selects the language of the returned hand names (default "en").
+	Locale string `json:"locale,omitempty"`
+}
+
+// BatchHandResult is one hand's result, or why it could not be evaluated.
+type BatchHandResult struct {
+	Hand  string `json:"hand,omitempty"`
+	Rank  int    `json:"rank,omitempty"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// BatchEvaluateResponse contains a result per hand, in request order.
+type BatchEvaluateResponse struct {
+	Results []BatchHandResult `json:"results"`
+}
+
+// ExcludedHand is the best hand that avoids the excluded ranks.
+type ExcludedHand struct {
+	Hand  string   `json:"hand"`
+	Rank  int      `json:"rank"`
+	Cards []string `json:"cards"`
 }
 
 // OddsRequest contains parameters for odds calculation.
+// An absent, null, or empty BoardCards means a preflop query.
 type OddsRequest struct {
+	HoleCards         []string   `json:"hole_cards,omitempty"`
+	HeroRange         string     `json:"hero_range,omitempty"`
+	BoardCards        []string   `json:"board_cards,omitempty"`
+	NumOpponents      int        `json:"num_opponents" binding:"required,min=1,max=9"`
+	OpponentHoleCards [][]string `json:"opponent_hole_cards,omitempty"`
+	FoldedPlayers     int        `json:"folded_players,omitempty" binding:"min=0"`
+	Boards            int        `json:"boards,omitempty" binding:"min=0,max=2"`
+	OpponentRange     string     `json:"opponent_range,omitempty"`
+	WeightedRange     bool       `json:"weighted_range,omitempty"`
+	DeadCards         []string   `json:"dead_cards,omitempty"`
+	BurnedCards       []string   `json:"burned_cards,omitempty"`
+	KnownOutOfPlay    []string   `json:"known_out_of_play,omitempty"`
+	TopLosingHands    int        `json:"top_losing_hands,omitempty" binding:"min=0,max=50"`
+	Simulations       int        `json:"simulations,omitempty"`
+	Workers           int        `json:"workers,omitempty"`
+	Seed              *Seed      `json:"seed,omitempty"`
+	Exact             bool       `json:"exact,omitempty"`
+	// TargetMargin picks the simulation count for a 95% confidence
+	// interval of ±TargetMargin on pot_share, up to Simulations.
+	TargetMargin float64 `json:"target_margin,omitempty" binding:"omitempty,gt=0,lt=1"`
+	// ChunkSize caps the simulations run between re-estimates of the
+	// simulation count a TargetMargin needs.
+	ChunkSize int `json:"chunk_size,omitempty" binding:"min=0"`
+	// SuggestMargin asks for the simulations a 95% confidence interval of
+	// ±SuggestMargin on pot_share would need, from the observed variance.
+	SuggestMargin float64 `json:"suggest_margin,omitempty" binding:"omitempty,gt=0,lt=1"`
+	// OpponentPosition and OpponentOpenPct give the opponent range as the
+	// top OpponentOpenPct percent of the position's built-in opening chart,
+	// instead of OpponentRange. OpponentOpenPct defaults to the full chart.
+	OpponentPosition string  `json:"opponent_position,omitempty"`
+	OpponentOpenPct  float64 `json:"opponent_open_pct,omitempty" binding:"omitempty,gt=0,max=100"`
+	// HoleUse plays an Omaha-style game where every hand uses exactly this
+	// many hole cards, e.g. 2 for Omaha and Courchevel.
+	HoleUse int `json:"hole_use,omitempty" binding:"omitempty,min=1,max=4"`
+	// IgnoreKickers decides showdowns by hand category alone, so hands of
+	// the same category tie.
+	IgnoreKickers bool `json:"ignore_kickers,omitempty"`
+	// Perspective reports the results from the seat of opponent_hole_cards
+	// entry Perspective (1-based) instead of the hero's.
+	Perspective int `json:"perspective,omitempty" binding:"min=0,max=9"`
+	// FoldFrequency is the probability (0-1) that the opponents fold to a
+	// bet, each fold a win without a showdown; otherwise they continue with
+	// their hands or opponent_range.
+	FoldFrequency float64 `json:"fold_frequency,omitempty" binding:"min=0,max=1"`
+	// Format is FormatFraction (default) or FormatPercent, the scale of the
+	// probabilities in the response.
+	Format string `json:"format,omitempty" binding:"omitempty,oneof=fraction percent"`
+	// OpponentFilter drops the opponent range combos that would have
+	// folded before now, judged on the known board.
+	OpponentFilter *RangeFilter `json:"opponent_filter,omitempty"`
+}
+
+// RangeFilter keeps the range combos still in the hand on the known board:
+// those making at least MinRank (1-10), for pairs at least the MinPair-th
+// pair on the board (1 = top pair), or with a strong draw when KeepDraws.
+type RangeFilter struct {
+	MinRank   int  `json:"min_rank" binding:"min=1,max=10"`
+	MinPair   int  `json:"min_pair,omitempty" binding:"min=0,max=5"`
+	KeepDraws bool `json:"keep_draws,omitempty"`
+}
+
+// OddsResponse contains calculated odds.
+type OddsResponse struct {
+	Win                     float64            `json:"win"`
+	Tie                     float64            `json:"tie"`
+	Loss                    float64            `json:"loss"`
+	PotShare                float64            `json:"pot_share"`
+	Summary                 string             `json:"summary"`
+	CurrentHand             string             `json:"current_hand,omitempty"`
+	CurrentRank             int                `json:"current_rank,omitempty"`
+	Draws                   []string           `json:"draws,omitempty"`
+	Simulations             int                `json:"simulations"`
+	StandardError           float64            `json:"standard_error"`
+	Margin                  float64            `json:"margin,omitempty"`
+	SuggestedSimulations    int                `json:"suggested_simulations,omitempty"`
+	WorkerWinVariance       float64            `json:"worker_win_variance"`
+	WorkerWinStdDev         float64            `json:"worker_win_std_dev"`
+	WinningHandDistribution map[string]float64 `json:"winning_hand_distribution"`
+	HeadToHead              []HeadToHead       `json:"head_to_head,omitempty"`
+	TopLosingHands          []HoldingFrequency `json:"top_losing_hands,omitempty"`
+	Classes                 int                `json:"classes,omitempty"`
+	FoldEquity              float64            `json:"fold_equity,omitempty"`
+	ShowdownPotShare        float64            `json:"showdown_pot_share,omitempty"`
+	Diagnostics             OddsDiagnostics    `json:"diagnostics"`
+	Cached                  bool               `json:"cached"`
+}
+
+// HoldingFrequency is how often a specific opponent holding occurred, as a
+// share of showdowns.
+type HoldingFrequency struct {
+	HoleCards []string `json:"hole_cards"`
+	Frequency float64  `json:"frequency"`
+}
+
+// OddsDiagnostics describes the cards removed from play before dealing.
+type OddsDiagnostics struct {
+	DeadCards      []string `json:"dead_cards,omitempty"`
+	BurnedCards    []string `json:"burned_cards,omitempty"`
+	KnownOutOfPlay []string `json:"known_out_of_play,omitempty"`
+	DeckSize       int      `json:"deck_size"`
+}
+
+// HeadToHead contains the hero's odds against one fixed opponent hand.
+type HeadToHead struct {
+	HoleCards []string `json:"hole_cards"`
+	Win       float64  `json:"win"`
+	Tie       float64  `json:"tie"`
+	Loss      float64  `json:"loss"`
+	// Matchup labels the matchup, such as "dominated" or "freeroll"; see
+	// simulator.HeadToHead.Matchup.
+	Matchup string `json:"matchup"`
+}
+
+// AllInPlayer is one player's hand and chips in an all-in request.
+type AllInPlayer struct {
+	HoleCards []string `json:"hole_cards" binding:"required"`
+	Committed int      `json:"committed" binding:"required,min=1"`
+}
+
+// AllInRequest contains an all-in showdown with per-player commitments.
+type AllInRequest struct {
+	Players     []AllInPlayer `json:"players" binding:"required,min=2,max=10,dive"`
+	BoardCards  []string      `json:"board_cards,omitempty"`
+	DeadCards   []string      `json:"dead_cards,omitempty"`
+	Simulations int           `json:"simulations,omitempty"`
+	Workers     int           `json:"workers,omitempty"`
+	Seed        *Seed         `json:"seed,omitempty"`
+}
+
+// Pot is the main pot or a side pot and the indexes of eligible players.
+type Pot struct {
+	Amount   int   `json:"amount"`
+	Eligible []int `json:"eligible"`
+}
+
+// AllInPlayerResult contains one player's expected chips from the pots.
+type AllInPlayerResult struct {
+	HoleCards     []string `json:"hole_cards"`
+	Committed     int      `json:"committed"`
+	ExpectedChips float64  `json:"expected_chips"`
+	Equity        float64  `json:"equity"`
+}
+
+// AllInResponse contains the pot structure and each player's chip EV.
+type AllInResponse struct {
+	Pots    []Pot               `json:"pots"`
+	Players []AllInPlayerResult `json:"players"`
+}
+
+// StandingRequest contains the hero's hand and the current board.
+type StandingRequest struct {
+	HoleCards  []string `json:"hole_cards" binding:"required"`
+	BoardCards []string `json:"board_cards,omitempty"`
+	DeadCards  []string `json:"dead_cards,omitempty"`
+}
+
+// StandingResponse contains the share of opponent holdings the hero is
+// currently ahead of, tied with, and behind.
+type StandingResponse struct {
+	Ahead  float64 `json:"ahead"`
+	Tied   float64 `json:"tied"`
+	Behind float64 `json:"behind"`
+	Combos int     `json:"combos"`
+}
+
+// TimelineRequest contains a hand to replay street by street.
+type TimelineRequest struct {
+	HoleCards         []string   `json:"hole_cards" binding:"required"`
+	BoardCards        []string   `json:"board_cards,omitempty"`
+	NumOpponents      int        `json:"num_opponents" binding:"required,min=1,max=9"`
+	OpponentHoleCards [][]string `json:"opponent_hole_cards,omitempty"`
+	DeadCards         []string   `json:"dead_cards,omitempty"`
+	Simulations       int        `json:"simulations,omitempty"`
+	Workers           int        `json:"workers,omitempty"`
+	Seed              *Seed      `json:"seed,omitempty"`
+}
+
+// StreetEquity is the hero's equity as it stood on one street.
+type StreetEquity struct {
+	Street   string  `json:"street"`
+	Win      float64 `json:"win"`
+	Tie      float64 `json:"tie"`
+	Loss     float64 `json:"loss"`
+	PotShare float64 `json:"pot_share"`
+	Exact    bool    `json:"exact"`
+}
+
+// TimelineResponse contains the hero's equity on each street and, when
+// the river and every opponent hand are known, the outcome.
+type TimelineResponse struct {
+	Streets []StreetEquity `json:"streets"`
+	Outcome string         `json:"outcome,omitempty"`
+}
+
+// HandOutcomesRequest contains the hero's hand and the current board.
+type HandOutcomesRequest struct {
+	HoleCards   []string `json:"hole_cards" binding:"required"`
+	BoardCards  []string `json:"board_cards,omitempty"`
+	DeadCards   []string `json:"dead_cards,omitempty"`
+	Exact       bool     `json:"exact,omitempty"`
+	Simulations int      `json:"simulations,omitempty"`
+	Workers     int      `json:"workers,omitempty"`
+	Seed        *Seed    `json:"seed,omitempty"`
+}
+
+// HandOutcomesResponse contains the probability of each hand category the
+// hero can finish the river with.
+type HandOutcomesResponse struct {
+	Distribution map[string]float64 `json:"distribution"`
+	Runouts      int                `json:"runouts"`
+	Exact        bool               `json:"exact"`
+}
+
+// CombinationCountResponse contains the size of an exact enumeration of an
+// odds request. The counts can exceed 64 bits, so they are encoded as
+// arbitrary-precision JSON numbers.
+type CombinationCountResponse struct {
+	Runouts       *big.Int `json:"runouts"`
+	OpponentDeals *big.Int `json:"opponent_deals"`
+	Combinations  *big.Int `json:"combinations"`
+	// Enumerable reports whether "exact": true supports the request, and
+	// WithinLimit whether Combinations is within MaxCombinations.
+	Enumerable      bool `json:"enumerable"`
+	WithinLimit     bool `json:"within_limit"`
+	MaxCombinations int  `json:"max_combinations"`
+}
+
+// DealRequest describes a random hand to deal: the number of players and
+// any cards fixed in advance.
+type DealRequest struct {
+	Players int `json:"players" binding:"required,min=2,max=10"`
+	// HoleCards fixes the hole cards of the first players, 0-2 cards each;
+	// missing cards are dealt.
+	HoleCards  [][]string `json:"hole_cards,omitempty"`
+	BoardCards []string   `json:"board_cards,omitempty"`
+	Seed       *Seed      `json:"seed,omitempty"`
+}
+
+// DealtPlayer is one player's cards and best hand in a dealt hand.
+type DealtPlayer struct {
+	HoleCards []string `json:"hole_cards"`
+	Hand      string   `json:"hand"`
+	Rank      int      `json:"rank"`
+	// Cards are the five cards that make the hand.
+	Cards []string `json:"cards"`
+}
+
+// DealResponse contains a complete dealt hand and its showdown. Winners
+// holds player indexes in request order, more than one on a split pot.
+type DealResponse struct {
+	Players    []DealtPlayer `json:"players"`
+	BoardCards []string      `json:"board_cards"`
+	Winners    []int         `json:"winners"`
+}
+
+// TraceRequest is an odds request whose first Traces simulated deals are
+// returned in full rather than tallied.
+type TraceRequest struct {
+	OddsRequest
+	Traces int `json:"traces" binding:"required,min=1,max=100"`
+}
+
+// TracedShowdown is one board of a traced deal. Players holds the hero
+// first and then each opponent in order; Winners holds their indexes,
+// more than one on a split pot.
+type TracedShowdown struct {
+	BoardCards []string      `json:"board_cards"`
+	Players    []DealtPlayer `json:"players"`
+	Winners    []int         `json:"winners"`
+}
+
+// TracedSimulation is one simulated deal, with a showdown per board.
+type TracedSimulation struct {
+	Simulation int              `json:"simulation"`
+	Showdowns  []TracedShowdown `json:"showdowns"`
+}
+
+// TraceResponse contains the traced deals in simulation order.
+type TraceResponse struct {
+	Simulations []TracedSimulation `json:"simulations"`
+}
+
+// DecisionRequest is an odds request facing a bet: Pot is the pot before
+// the bet, and Bet the amount to call, in any unit of chips.
+type DecisionRequest struct {
+	OddsRequest
+	Pot float64 `json:"pot" binding:"required,gt=0"`
+	Bet float64 `json:"bet" binding:"required,gt=0"`
+}
+
+// DecisionResponse compares the hero's equity with the equity a call
+// needs, bet/(pot+2*bet), and recommends "call" or "fold". Close is set
+// when the margin is within the 95% confidence interval of the equity.
+type DecisionResponse struct {
+	RequiredEquity float64 `json:"required_equity"`
+	Equity         float64 `json:"equity"`
+	EquityMargin   float64 `json:"equity_margin"`
+	CallEV         float64 `json:"call_ev"`
+	Action         string  `json:"action"`
+	Close          bool    `json:"close"`
+	Simulations    int     `json:"simulations"`
+}
+
+// HandClassRequest contains a hand of 5-7 cards.
+type HandClassRequest struct {
+	Cards []string `json:"cards" binding:"required"`
+}
+
+// HandClassResponse contains the best hand's strength class.
+type HandClassResponse struct {
+	// Class is in [1, 7462]; 1 is a royal flush and lower classes win.
+	Class int      `json:"class"`
+	Hand  string   `json:"hand"`
+	Rank  int      `json:"rank"`
+	Cards []string `json:"cards"`
+}
+
+// DrawOddsRequest contains a five-card draw hand and the positions the
+// hero discards before showdown.
+type DrawOddsRequest struct {
 	HoleCards    []string `json:"hole_cards" binding:"required"`
-	BoardCards   []string `json:"board_cards" binding:"required"`
+	Discards     []int    `json:"discards,omitempty" binding:"omitempty,max=5,dive,min=0,max=4"`
 	NumOpponents int      `json:"num_opponents" binding:"required,min=1,max=9"`
+	DeadCards    []string `json:"dead_cards,omitempty"`
 	Simulations  int      `json:"simulations,omitempty"`
 	Workers      int      `json:"workers,omitempty"`
+	Seed         *Seed    `json:"seed,omitempty"`
 }
 
-// OddsResponse contains calculated odds.
-type OddsResponse struct {
-	Win  float64 `json:"win"`
-	Tie  float64 `json:"tie"`
-	Loss float64 `json:"loss"`
+// DrawOddsResponse contains five-card draw odds.
+type DrawOddsResponse struct {
+	Win                     float64            `json:"win"`
+	Tie                     float64            `json:"tie"`
+	Loss                    float64            `json:"loss"`
+	PotShare                float64            `json:"pot_share"`
+	Simulations             int                `json:"simulations"`
+	StandardError           float64            `json:"standard_error"`
+	WinningHandDistribution map[string]float64 `json:"winning_hand_distribution"`
+}
+
+// QuickPreflopRequest contains the hero's two hole cards.
+type QuickPreflopRequest struct {
+	HoleCards []string `json:"hole_cards" binding:"required"`
+}
+
+// QuickPreflopResponse contains a simulation-free preflop rating.
+type QuickPreflopResponse struct {
+	// Hand is the canonical starting hand, such as "AKs".
+	Hand      string `json:"hand"`
+	ChenScore int    `json:"chen_score"`
+	// Bucket is "strong", "medium", or "weak".
+	Bucket string `json:"bucket"`
+}
+
+// PreflopGridRequest describes the opponent of a preflop equity grid:
+// fixed hole cards, a range, or neither for a random hand.
+type PreflopGridRequest struct {
+	OpponentHoleCards []string `json:"opponent_hole_cards,omitempty"`
+	OpponentRange     string   `json:"opponent_range,omitempty"`
+	WeightedRange     bool     `json:"weighted_range,omitempty"`
+	DeadCards         []string `json:"dead_cards,omitempty"`
+	// Simulations is the number of simulations for each starting hand.
+	Simulations int   `json:"simulations,omitempty"`
+	Workers     int   `json:"workers,omitempty"`
+	Seed        *Seed `json:"seed,omitempty"`
+}
+
+// GridCell is the hero's equity with one starting hand.
+type GridCell struct {
+	Hand   string  `json:"hand"`
+	Equity float64 `json:"equity"`
+	Combos int     `json:"combos"`
+}
+
+// PreflopGridResponse contains the 13x13 grid of starting hand equities,
+// aces first, with suited hands above the diagonal.
+type PreflopGridResponse struct {
+	Grid [][]GridCell `json:"grid"`
+}
+
+// CompareHandsRequest contains two hands of 5-7 cards to compare.
+type CompareHandsRequest struct {
+	HandA []string `json:"hand_a" binding:"required"`
+	HandB []string `json:"hand_b" binding:"required"`
+}
+
+// CompareHandsResponse contains both hands and the comparison ruling.
+type CompareHandsResponse struct {
+	HandA string `json:"hand_a"`
+	HandB string `json:"hand_b"`
+	// Result is 1 if hand A wins, -1 if hand B wins, 0 for a tie.
+	Result      int    `json:"result"`
+	Winner      string `json:"winner"`
+	Explanation string `json:"explanation"`
+	// TieBreak is the ruling in structured form, for logging.
+	TieBreak TieBreak `json:"tie_break"`
+}
+
+// TieBreak records how a comparison was decided. DecidedBy is "rank",
+// "kicker", "tie", or "board plays" for a tie on the same five cards. For
+// a kicker, Position is the 1-based tiebreak card and WinningCard and
+// LosingCard its ranks.
+type TieBreak struct {
+	DecidedBy   string `json:"decided_by"`
+	Position    int    `json:"position,omitempty"`
+	WinningCard string `json:"winning_card,omitempty"`
+	LosingCard  string `json:"losing_card,omitempty"`
+}
+
+// CompareEquityRequest contains two candidate hero hands to compare in the
+// same spot.
+type CompareEquityRequest struct {
+	HandA        []string `json:"hand_a" binding:"required"`
+	HandB        []string `json:"hand_b" binding:"required"`
+	BoardCards   []string `json:"board_cards,omitempty"`
+	DeadCards    []string `json:"dead_cards,omitempty"`
+	NumOpponents int      `json:"num_opponents" binding:"required,min=1,max=9"`
+	Simulations  int      `json:"simulations,omitempty"`
+	Workers      int      `json:"workers,omitempty"`
+	Seed         *Seed    `json:"seed,omitempty"`
+}
+
+// HandEquity contains one candidate hand's odds and equity.
+type HandEquity struct {
+	HoleCards     []string `json:"hole_cards"`
+	Win           float64  `json:"win"`
+	Tie           float64  `json:"tie"`
+	Loss          float64  `json:"loss"`
+	Equity        float64  `json:"equity"`
+	StandardError float64  `json:"standard_error"`
+}
+
+// CompareEquityResponse contains both hands' equity and whether the
+// difference between them is statistically meaningful.
+type CompareEquityResponse struct {
+	HandA             HandEquity `json:"hand_a"`
+	HandB             HandEquity `json:"hand_b"`
+	Difference        float64    `json:"difference"`
+	StandardError     float64    `json:"standard_error"`
+	StatisticallyTied bool       `json:"statistically_tied"`
+	// Better is "a", "b", or "tie" when the hands are statistically tied.
+	Better  string `json:"better"`
+	Summary string `json:"summary"`
+}
+
+// RankHandsRequest contains candidate hero hands to rank by equity in the
+// same spot.
+type RankHandsRequest struct {
+	Hands        [][]string `json:"hands" binding:"required,min=2,max=50"`
+	BoardCards   []string   `json:"board_cards,omitempty"`
+	DeadCards    []string   `json:"dead_cards,omitempty"`
+	NumOpponents int        `json:"num_opponents" binding:"required,min=1,max=9"`
+	Simulations  int        `json:"simulations,omitempty"`
+	Workers      int        `json:"workers,omitempty"`
+	Seed         *Seed      `json:"seed,omitempty"`
+}
+
+// RankedHand is one candidate hand's place in a ranking.
+type RankedHand struct {
+	// Rank is the hand's 1-based place, best first.
+	Rank int `json:"rank"`
+	// Index is the hand's 0-based position in the request.
+	Index int `json:"index"`
+	HandEquity
+	// Lead is the hand's equity lead over the next-ranked hand, omitted for
+	// the last.
+	Lead *EquityLead `json:"lead,omitempty"`
+}
+
+// EquityLead is one hand's equity lead over another with its 95%
+// confidence interval, from paired samples.
+type EquityLead struct {
+	Difference        float64 `json:"difference"`
+	StandardError     float64 `json:"standard_error"`
+	Margin            float64 `json:"margin"`
+	StatisticallyTied bool    `json:"statistically_tied"`
+}
+
+// RankHandsResponse lists the candidate hands, best equity first.
+type RankHandsResponse struct {
+	Hands       []RankedHand `json:"hands"`
+	Simulations int          `json:"simulations"`
+}
+
+// Probability formats accepted in OddsRequest.Format.
+const (
+	// FormatFraction reports probabilities from 0 to 1, e.g. 0.55.
+	FormatFraction = "fraction"
+	// FormatPercent reports probabilities from 0 to 100, e.g. 55.0.
+	FormatPercent = "percent"
+)
+
+// Job statuses reported in JobResponse.Status.
+const (
+	JobRunning = "running"
+	JobDone    = "done"
+	JobFailed  = "failed"
+)
+
+// JobResponse describes a background odds job. Result holds the odds once
+// the job is done, and Error why it failed.
+type JobResponse struct {
+	ID     string         `json:"id"`
+	Status string         `json:"status"`
+	Result *OddsResponse  `json:"result,omitempty"`
+	Error  *ErrorResponse `json:"error,omitempty"`
 }
 
 // ErrorResponse contains error information.
 type ErrorResponse struct {
 	Error string `json:"error"`
+	Code  string `json:"code"`
 }
+
+// Error codes returned in ErrorResponse.Code.
+const (
+	// CodeInvalidRequest: a field is missing, out of range, or of the wrong
+	// type, or the request is otherwise invalid.
+	CodeInvalidRequest = "INVALID_REQUEST"
+	// CodeMalformedJSON: the body is empty or not valid JSON.
+	CodeMalformedJSON = "MALFORMED_JSON"
+	// CodeInvalidCard: a card code could not be parsed.
+	CodeInvalidCard = "INVALID_CARD"
+	// CodeDuplicateCard: the same card appears more than once.
+	CodeDuplicateCard = "DUPLICATE_CARD"
+	// CodeInvalidCardCount: too few or too many cards for the request.
+	CodeInvalidCardCount = "INVALID_CARD_COUNT"
+	// CodeTooManyCards: more cards than the evaluate variant allows.
+	CodeTooManyCards = "TOO_MANY_CARDS"
+	// CodeInvalidRange: a range could not be parsed or has no usable combos.
+	CodeInvalidRange = "INVALID_RANGE"
+	// CodeRangeConflict: ranges are too narrow to deal without shared cards.
+	CodeRangeConflict = "RANGE_CONFLICT"
+	// CodeUnknownVariant: the requested variant is not supported.
+	CodeUnknownVariant = "UNKNOWN_VARIANT"
+	// CodeTooManyOpponents: the deck cannot deal every player.
+	CodeTooManyOpponents = "TOO_MANY_OPPONENTS"
+	// CodeSimulationCapExceeded: more simulations than the server allows.
+	CodeSimulationCapExceeded = "SIMULATION_CAP_EXCEEDED"
+	// CodeBatchTooLarge: a batch holds more hands than the server allows.
+	CodeBatchTooLarge = "BATCH_TOO_LARGE"
+	// CodeBodyTooLarge: the request body is larger than the server allows.
+	CodeBodyTooLarge = "BODY_TOO_LARGE"
+	// CodeTimeout: the request did not finish within the server's limit.
+	CodeTimeout = "TIMEOUT"
+	// CodeJobNotFound: no job has the ID, or it has expired.
+	CodeJobNotFound = "JOB_NOT_FOUND"
+	// CodeTooManyJobs: the server is already running as many jobs as it allows.
+	CodeTooManyJobs = "TOO_MANY_JOBS"
+	// CodeNoSimulations: the server is configured to run no simulations.
+	CodeNoSimulations = "NO_SIMULATIONS"
+	// CodeInternal: the server failed to produce a result.
+	CodeInternal = "INTERNAL_ERROR"
+)
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Seed is a random seed. In JSON it is a number or a string, because a
+// JavaScript number cannot carry every int64 exactly: a string may hold a
+// decimal or 0x-prefixed hex integer, optionally negative. Values from
+// 2^63 up to 2^64-1 wrap around to negative seeds, so any 64-bit unsigned
+// seed can be sent as is.
+type Seed int64
+
+// UnmarshalJSON parses a seed from a JSON number or string.
+func (s *Seed) UnmarshalJSON(data []byte) error {
+	text := string(bytes.TrimSpace(data))
+	base := 10
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		base = 0
+	}
+
+	seed, err := parseSeed(text, base)
+	if err != nil {
+		return err
+	}
+	*s = seed
+	return nil
+}
+
+// parseSeed parses a seed in the given base, where base 0 accepts a 0x,
+// 0o or 0b prefix as strconv.ParseInt does. Unsigned values above the
+// int64 range wrap around; anything beyond 64 bits is an error.
+func parseSeed(text string, base int) (Seed, error) {
+	text = strings.TrimSpace(text)
+	n, err := strconv.ParseInt(text, base, 64)
+	if err == nil {
+		return Seed(n), nil
+	}
+	if errors.Is(err, strconv.ErrRange) && !strings.HasPrefix(text, "-") {
+		if u, uerr := strconv.ParseUint(text, base, 64); uerr == nil {
+			return Seed(int64(u)), nil
+		}
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("seed %q does not fit in 64 bits", text)
+	}
+	return 0, fmt.Errorf("invalid seed %q: must be a decimal or 0x-prefixed hex integer", text)
+}